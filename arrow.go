@@ -0,0 +1,386 @@
+package gander
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/bitutil"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+// ToArrowRecord converts the DataFrame to an Arrow record batch, which
+// the caller must Release. Numeric Series become Float64 arrays that share
+// memory with the Series values, so no data is copied and the Series must
+// not be changed while the record is in use. Categorical Series become
+// dictionary arrays of strings. Missing (NaN) values are marked as nulls.
+func (d *DataFrame) ToArrowRecord() arrow.RecordBatch {
+	return d.arrowRecord(memory.DefaultAllocator, false)
+}
+
+// FromArrowRecord creates a DataFrame from an Arrow record batch, copying
+// the data. String, binary and dictionary columns become categorical
+// Series. Numeric, boolean, date and timestamp columns become numeric
+// Series, with dates and timestamps converted to Unix timestamps in
+// seconds. Nulls become NaN.
+func FromArrowRecord(r arrow.RecordBatch) (*DataFrame, error) {
+	t := array.NewTableFromRecords(r.Schema(), []arrow.RecordBatch{r})
+	defer t.Release()
+
+	return dataFrameFromArrow(t)
+}
+
+// WriteArrowIPC writes the DataFrame to w as an Arrow IPC stream,
+// converting it as ToArrowRecord does.
+func (d *DataFrame) WriteArrowIPC(w io.Writer) error {
+	r := d.ToArrowRecord()
+	defer r.Release()
+
+	iw := ipc.NewWriter(w, ipc.WithSchema(r.Schema()))
+	if err := iw.Write(r); err != nil {
+		iw.Close()
+		return err
+	}
+
+	return iw.Close()
+}
+
+// WriteArrowFile writes the DataFrame to w in the Arrow IPC file
+// format, which is also used by Feather version 2, converting it as
+// ToArrowRecord does.
+func (d *DataFrame) WriteArrowFile(w io.Writer) error {
+	r := d.ToArrowRecord()
+	defer r.Release()
+
+	fw, err := ipc.NewFileWriter(w, ipc.WithSchema(r.Schema()))
+	if err != nil {
+		return err
+	}
+
+	if err := fw.Write(r); err != nil {
+		fw.Close()
+		return err
+	}
+
+	return fw.Close()
+}
+
+// ReadArrowIPC creates a DataFrame from an Arrow IPC stream or file, such
+// as a Feather version 2 file, converting it as FromArrowRecord does. The
+// format is detected from the data. All the record batches are combined.
+func ReadArrowIPC(r io.Reader) (*DataFrame, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(len(ipc.Magic))
+
+	var schema *arrow.Schema
+	records := []arrow.RecordBatch{}
+	defer func() {
+		for _, rec := range records {
+			rec.Release()
+		}
+	}()
+
+	if bytes.Equal(magic, ipc.Magic) {
+		b, err := io.ReadAll(br)
+		if err != nil {
+			return nil, err
+		}
+
+		fr, err := ipc.NewFileReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+
+		defer fr.Close()
+
+		schema = fr.Schema()
+		for i := 0; i < fr.NumRecords(); i++ {
+			rec, err := fr.RecordBatch(i)
+			if err != nil {
+				return nil, err
+			}
+			rec.Retain()
+			records = append(records, rec)
+		}
+	} else {
+		sr, err := ipc.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+
+		defer sr.Release()
+
+		schema = sr.Schema()
+		for sr.Next() {
+			rec := sr.RecordBatch()
+			rec.Retain()
+			records = append(records, rec)
+		}
+		if sr.Err() != nil {
+			return nil, sr.Err()
+		}
+	}
+
+	t := array.NewTableFromRecords(schema, records)
+	defer t.Release()
+
+	return dataFrameFromArrow(t)
+}
+
+// arrowRecord converts the DataFrame to an Arrow record batch.
+// Numeric Series become Int64 arrays if integers is true and every
+// value is a whole number, otherwise they become Float64 arrays.
+func (d *DataFrame) arrowRecord(mem memory.Allocator, integers bool) arrow.RecordBatch {
+	fields := []arrow.Field{}
+	cols := []arrow.Array{}
+
+	for _, s := range *d {
+		var a arrow.Array
+		switch {
+		case s.IsCategorical():
+			a = s.arrowDictionary(mem)
+		case integers && s.isInt64():
+			b := array.NewInt64Builder(mem)
+			for _, v := range s.Values {
+				if isNA(v) {
+					b.AppendNull()
+				} else {
+					b.Append(int64(v))
+				}
+			}
+			a = b.NewArray()
+			b.Release()
+		default:
+			a = s.arrowFloat64(mem)
+		}
+		fields = append(fields, arrow.Field{Name: s.Name, Type: a.DataType(), Nullable: true})
+		cols = append(cols, a)
+	}
+
+	r := array.NewRecordBatch(arrow.NewSchema(fields, nil), cols, int64(d.rowCount()))
+	for _, a := range cols {
+		a.Release()
+	}
+
+	return r
+}
+
+// arrowFloat64 converts a numeric Series to a Float64 array that uses
+// the Series values as its data buffer.
+func (s *Series) arrowFloat64(mem memory.Allocator) arrow.Array {
+	nulls := 0
+	for _, v := range s.Values {
+		if isNA(v) {
+			nulls++
+		}
+	}
+
+	var valid *memory.Buffer
+	if nulls > 0 {
+		valid = memory.NewResizableBuffer(mem)
+		valid.Resize(int(bitutil.BytesForBits(int64(len(s.Values)))))
+		defer valid.Release()
+		for i, v := range s.Values {
+			bitutil.SetBitTo(valid.Bytes(), i, isNA(v) == false)
+		}
+	}
+
+	values := memory.NewBufferBytes(arrow.Float64Traits.CastToBytes(s.Values))
+	data := array.NewData(arrow.PrimitiveTypes.Float64, len(s.Values), []*memory.Buffer{valid, values}, nil, nulls, 0)
+	defer data.Release()
+
+	return array.NewFloat64Data(data)
+}
+
+// arrowDictionary converts a categorical Series to a dictionary
+// array, with the categories ordered by their values.
+func (s *Series) arrowDictionary(mem memory.Allocator) arrow.Array {
+	codes := []float64{}
+	for k := range s.categoricalLabels {
+		codes = append(codes, k)
+	}
+	sort.Float64s(codes)
+
+	db := array.NewStringBuilder(mem)
+	defer db.Release()
+	index := map[float64]int32{}
+	for i, c := range codes {
+		db.Append(s.categoricalLabels[c])
+		index[c] = int32(i)
+	}
+	dict := db.NewArray()
+	defer dict.Release()
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	for _, v := range s.Values {
+		if i, ok := index[v]; ok {
+			ib.Append(i)
+		} else {
+			ib.AppendNull()
+		}
+	}
+	indices := ib.NewArray()
+	defer indices.Release()
+
+	t := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int32, ValueType: arrow.BinaryTypes.String}
+
+	return array.NewDictionaryArray(t, indices, dict)
+}
+
+// isInt64 returns true if every value that is not missing is a
+// whole number that can be held in an int64.
+func (s *Series) isInt64() bool {
+	if s.isWholeNumbers() == false {
+		return false
+	}
+
+	for _, v := range s.Values {
+		if v >= math.MaxInt64 || v < math.MinInt64 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// dataFrameFromArrow creates a DataFrame from the columns of an
+// Arrow table, converting them as described for FromArrowRecord.
+func dataFrameFromArrow(t arrow.Table) (*DataFrame, error) {
+	d := DataFrame{}
+
+	for i := 0; i < int(t.NumCols()); i++ {
+		c := t.Column(i)
+		s, err := seriesFromArrow(c.Name(), c.Data().Chunks())
+		if err != nil {
+			return nil, err
+		}
+		d = append(d, s)
+	}
+
+	return &d, nil
+}
+
+func seriesFromArrow(name string, chunks []arrow.Array) (*Series, error) {
+	values := []float64{}
+	s := NewCategoricalSeries(name, []string{})
+	categorical := len(chunks) > 0 && isArrowCategorical(chunks[0].DataType())
+
+	for _, a := range chunks {
+		for i := 0; i < a.Len(); i++ {
+			if categorical {
+				if a.IsNull(i) {
+					s.Values = append(s.Values, math.NaN())
+					continue
+				}
+				l, err := arrowLabel(a, i)
+				if err != nil {
+					return nil, fmt.Errorf("column '%s' %s", name, err)
+				}
+				s.appendLabel(l)
+				continue
+			}
+
+			if a.IsNull(i) {
+				values = append(values, math.NaN())
+				continue
+			}
+			v, err := arrowValue(a, i)
+			if err != nil {
+				return nil, fmt.Errorf("column '%s' %s", name, err)
+			}
+			values = append(values, v)
+		}
+	}
+
+	if categorical {
+		return s, nil
+	}
+
+	return NewSeries(name, values), nil
+}
+
+func isArrowCategorical(t arrow.DataType) bool {
+	switch t.ID() {
+	case arrow.STRING, arrow.LARGE_STRING, arrow.BINARY, arrow.LARGE_BINARY, arrow.DICTIONARY:
+		return true
+	}
+
+	return false
+}
+
+func arrowLabel(a arrow.Array, i int) (string, error) {
+	switch a := a.(type) {
+	case *array.String:
+		return a.Value(i), nil
+	case *array.LargeString:
+		return a.Value(i), nil
+	case *array.Binary:
+		return string(a.Value(i)), nil
+	case *array.LargeBinary:
+		return string(a.Value(i)), nil
+	case *array.Dictionary:
+		return arrowLabel(a.Dictionary(), a.GetValueIndex(i))
+	}
+
+	return "", fmt.Errorf("has unsupported type %s", a.DataType())
+}
+
+func arrowValue(a arrow.Array, i int) (float64, error) {
+	switch a := a.(type) {
+	case *array.Float64:
+		return a.Value(i), nil
+	case *array.Float32:
+		return float64(a.Value(i)), nil
+	case *array.Int8:
+		return float64(a.Value(i)), nil
+	case *array.Int16:
+		return float64(a.Value(i)), nil
+	case *array.Int32:
+		return float64(a.Value(i)), nil
+	case *array.Int64:
+		return float64(a.Value(i)), nil
+	case *array.Uint8:
+		return float64(a.Value(i)), nil
+	case *array.Uint16:
+		return float64(a.Value(i)), nil
+	case *array.Uint32:
+		return float64(a.Value(i)), nil
+	case *array.Uint64:
+		return float64(a.Value(i)), nil
+	case *array.Boolean:
+		if a.Value(i) {
+			return 1, nil
+		}
+		return 0, nil
+	case *array.Date32:
+		return float64(a.Value(i)) * 24 * 60 * 60, nil
+	case *array.Date64:
+		return float64(a.Value(i)) / 1000, nil
+	case *array.Timestamp:
+		u := a.DataType().(*arrow.TimestampType).Unit
+		return float64(a.Value(i)) * float64(u.Multiplier()) / float64(time.Second), nil
+	}
+
+	return 0, fmt.Errorf("has unsupported type %s", a.DataType())
+}
+
+// appendLabel appends a categorical value to the Series, adding
+// a new category if the label has not been seen before.
+func (s *Series) appendLabel(l string) {
+	v, ok := s.categoricalValues[l]
+	if ok == false {
+		v = float64(len(s.categoricalValues))
+		s.categoricalValues[l] = v
+		s.categoricalLabels[v] = l
+	}
+
+	s.Values = append(s.Values, v)
+}
@@ -0,0 +1,110 @@
+package gander
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func createArrowTestData() *DataFrame {
+	return &DataFrame{
+		NewSeries("a", []float64{1.5, math.NaN(), 3}),
+		NewCategoricalSeries("b", []string{"x", "y", "x"}),
+	}
+}
+
+func TestToArrowRecord(t *testing.T) {
+	df := createArrowTestData()
+	r := df.ToArrowRecord()
+	defer r.Release()
+
+	assert.Equal(t, int64(3), r.NumRows(), "row count is not correct")
+	assert.Equal(t, arrow.PrimitiveTypes.Float64, r.Column(0).DataType(), "numeric type is not correct")
+	assert.Equal(t, arrow.DICTIONARY, r.Column(1).DataType().ID(), "categorical type is not correct")
+	assert.Equal(t, true, r.Column(0).IsNull(1), "NaN should be null")
+	assert.Equal(t, 1, r.Column(0).NullN(), "null count is not correct")
+
+	a := r.Column(0).(*array.Float64)
+	assert.Equal(t, &(*df)[0].Values[0], &a.Float64Values()[0], "values should not be copied")
+
+	d := r.Column(1).(*array.Dictionary)
+	assert.Equal(t, "y", d.Dictionary().(*array.String).Value(d.GetValueIndex(1)), "label is not correct")
+}
+
+func TestFromArrowRecord(t *testing.T) {
+	r := createArrowTestData().ToArrowRecord()
+	defer r.Release()
+
+	df, err := FromArrowRecord(r)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"a", "b"}, df.ColumnNames(), "column names are not correct")
+	assert.Equal(t, true, valuesMatch([]float64{1.5, na, 3}, (*df)[0].Values), "values are not correct")
+	assert.Equal(t, "y", (*df)[1].label((*df)[1].Values[1]), "label is not correct")
+}
+
+func TestFromArrowRecordUnsupportedType(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "l", Type: arrow.ListOf(arrow.PrimitiveTypes.Int64)}}, nil)
+	b := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer b.Release()
+	b.Field(0).(*array.ListBuilder).Append(true)
+	r := b.NewRecordBatch()
+	defer r.Release()
+
+	_, err := FromArrowRecord(r)
+	assert.Equal(t, "column 'l' has unsupported type list<item: int64, nullable>", err.Error(), "error message is not correct")
+}
+
+func TestWriteArrowIPC(t *testing.T) {
+	b := bytes.Buffer{}
+	err := createArrowTestData().WriteArrowIPC(&b)
+	assert.Equal(t, nil, err, "error is not nil")
+
+	df, err := ReadArrowIPC(&b)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, valuesMatch([]float64{1.5, na, 3}, (*df)[0].Values), "values are not correct")
+	assert.Equal(t, true, (*df)[1].IsCategorical(), "Series should be categorical")
+	assert.Equal(t, "x", (*df)[1].label((*df)[1].Values[2]), "label is not correct")
+}
+
+func TestWriteArrowFile(t *testing.T) {
+	b := bytes.Buffer{}
+	err := createArrowTestData().WriteArrowFile(&b)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, bytes.HasPrefix(b.Bytes(), ipc.Magic), "file should start with the Arrow magic bytes")
+
+	df, err := ReadArrowIPC(&b)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, valuesMatch([]float64{1.5, na, 3}, (*df)[0].Values), "values are not correct")
+	assert.Equal(t, "y", (*df)[1].label((*df)[1].Values[1]), "label is not correct")
+}
+
+func TestReadArrowIPCMultipleBatches(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "s", Type: arrow.BinaryTypes.String},
+	}, nil)
+
+	b := bytes.Buffer{}
+	w := ipc.NewWriter(&b, ipc.WithSchema(schema))
+	rb := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer rb.Release()
+	for _, v := range []int32{1, 2} {
+		rb.Field(0).(*array.Int32Builder).Append(v)
+		rb.Field(1).(*array.StringBuilder).Append(string(rune('a' + v)))
+		r := rb.NewRecordBatch()
+		w.Write(r)
+		r.Release()
+	}
+	w.Close()
+
+	df, err := ReadArrowIPC(&b)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []float64{1, 2}, (*df)[0].Values, "values are not correct")
+	assert.Equal(t, "c", (*df)[1].label((*df)[1].Values[1]), "label is not correct")
+}
@@ -0,0 +1,176 @@
+package gander
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Cut assigns each value in the Series to a bin, returning a new categorical
+// Series holding the label of each value's bin. The bins are defined by
+// edges, which must be finite and increasing, so that n edges give n-1
+// bins. If right is true, bins include their right edge, giving labels
+// such as "(40, 50]", otherwise they include their left edge, giving
+// labels such as "[40, 50)". If labels is nil the interval labels are
+// used, otherwise labels must hold one label per bin. Values that do not
+// fall into any bin are set to NaN.
+func (s *Series) Cut(edges []float64, labels []string, right bool) (*Series, error) {
+	return s.cut(edges, labels, right, false)
+}
+
+// QCut assigns each value in the Series to one of q bins, each holding
+// roughly the same number of values, and returns a new categorical Series
+// in the same way as Cut. The bins include their right edge, and the first
+// bin also includes the minimum value. The bins are found from the values
+// that are finite, and missing (NaN) and infinite values are set to NaN.
+func (s *Series) QCut(q int) (*Series, error) {
+	if q < 1 {
+		return nil, errors.New("number of bins must be at least 1")
+	}
+
+	if s.IsCategorical() == true {
+		return nil, fmt.Errorf("Series %s is categorical", s.Name)
+	}
+
+	v := NewSeries(s.Name, s.finite()).Sorted()
+	if len(v) == 0 {
+		return nil, fmt.Errorf("Series %s has no values", s.Name)
+	}
+
+	edges := []float64{}
+	for i := 0; i <= q; i++ {
+		edges = append(edges, quantile(v, float64(i)/float64(q)))
+	}
+
+	return s.cut(edges, nil, true, true)
+}
+
+// equalWidthEdges returns the edges of n bins of equal width covering
+// the range of the values in the Series that are finite. The Series
+// must hold at least one finite value.
+func (s *Series) equalWidthEdges(n int) []float64 {
+	min, max := NewSeries(s.Name, s.finite()).Range()
+
+	if min == max {
+		min -= 0.5
+		max += 0.5
+	}
+
+	edges := []float64{}
+	for i := 0; i <= n; i++ {
+		edges = append(edges, min+(max-min)*float64(i)/float64(n))
+	}
+	edges[n] = max
+
+	return edges
+}
+
+func (s *Series) cut(edges []float64, labels []string, right bool, includeLowest bool) (*Series, error) {
+	if s.IsCategorical() == true {
+		return nil, fmt.Errorf("Series %s is categorical", s.Name)
+	}
+
+	if len(edges) < 2 {
+		return nil, errors.New("at least 2 bin edges are required")
+	}
+
+	for i := range edges {
+		if isFinite(edges[i]) == false {
+			return nil, errors.New("bin edges must be finite")
+		}
+		if i > 0 && edges[i] <= edges[i-1] {
+			return nil, errors.New("bin edges must be increasing and unique")
+		}
+	}
+
+	if labels == nil {
+		labels = intervalLabels(edges, right, includeLowest)
+	} else if len(labels) != len(edges)-1 {
+		return nil, fmt.Errorf("%v labels are required for %v bin edges", len(edges)-1, len(edges))
+	}
+
+	r := Series{Name: s.Name, Values: make([]float64, len(s.Values))}
+	r.categoricalLabels = make(map[float64]string)
+	r.categoricalValues = make(map[string]float64)
+
+	for i, l := range labels {
+		r.categoricalLabels[float64(i)] = l
+		r.categoricalValues[l] = float64(i)
+	}
+
+	for i, v := range s.Values {
+		r.Values[i] = float64(findBin(edges, v, right, includeLowest))
+		if r.Values[i] < 0 {
+			r.Values[i] = math.NaN()
+		}
+	}
+
+	return &r, nil
+}
+
+// findBin returns the index of the bin that v falls into,
+// or -1 if it does not fall into any bin.
+func findBin(edges []float64, v float64, right bool, includeLowest bool) int {
+	last := len(edges) - 1
+
+	if math.IsNaN(v) || v < edges[0] || v > edges[last] {
+		return -1
+	}
+
+	if right == true {
+		if v == edges[0] {
+			if includeLowest == true {
+				return 0
+			}
+			return -1
+		}
+		for i := 1; i <= last; i++ {
+			if v <= edges[i] {
+				return i - 1
+			}
+		}
+	} else {
+		if v == edges[last] {
+			if includeLowest == true {
+				return last - 1
+			}
+			return -1
+		}
+		for i := 1; i <= last; i++ {
+			if v < edges[i] {
+				return i - 1
+			}
+		}
+	}
+
+	return -1
+}
+
+func intervalLabels(edges []float64, right bool, includeLowest bool) []string {
+	l := []string{}
+	last := len(edges) - 2
+
+	for i := 0; i < len(edges)-1; i++ {
+		lo, hi := formatEdge(edges[i]), formatEdge(edges[i+1])
+		switch {
+		case right == true && includeLowest == true && i == 0:
+			l = append(l, fmt.Sprintf("[%s, %s]", lo, hi))
+		case right == true:
+			l = append(l, fmt.Sprintf("(%s, %s]", lo, hi))
+		case includeLowest == true && i == last:
+			l = append(l, fmt.Sprintf("[%s, %s]", lo, hi))
+		default:
+			l = append(l, fmt.Sprintf("[%s, %s)", lo, hi))
+		}
+	}
+
+	return l
+}
+
+// formatEdge formats a bin edge to 10 significant figures, so that
+// rounding errors such as 0.30000000000000004 do not appear in labels.
+func formatEdge(v float64) string {
+	r, _ := strconv.ParseFloat(strconv.FormatFloat(v, 'g', 10, 64), 64)
+	return strconv.FormatFloat(r, 'f', -1, 64)
+}
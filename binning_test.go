@@ -0,0 +1,99 @@
+package gander
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCutRightInclusive(t *testing.T) {
+	s := NewSeries("age", []float64{35, 40, 41, 50, 55, 60})
+	c, err := s.Cut([]float64{40, 50, 60}, nil, true)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, c.IsCategorical(), "series is not categorical")
+	assert.Equal(t, true, math.IsNaN(c.Values[0]), "value below the bins was not set to NaN")
+	assert.Equal(t, true, math.IsNaN(c.Values[1]), "lowest edge was included")
+	e := []string{"(40, 50]", "(40, 50]", "(50, 60]", "(50, 60]"}
+	for i, v := range e {
+		assert.Equal(t, v, c.categoricalLabels[c.Values[i+2]], "value is in the wrong bin")
+	}
+}
+
+func TestCutLeftInclusiveWithLabels(t *testing.T) {
+	s := NewSeries("age", []float64{40, 49, 50, 59})
+	c, err := s.Cut([]float64{40, 50, 60}, []string{"forties", "fifties"}, false)
+	assert.Equal(t, nil, err, "error is not nil")
+	e := []string{"forties", "forties", "fifties", "fifties"}
+	for i, v := range e {
+		assert.Equal(t, v, c.categoricalLabels[c.Values[i]], "value is in the wrong bin")
+	}
+}
+
+func TestCutInvalidEdges(t *testing.T) {
+	s := createTestSeries()
+	_, err := s.Cut([]float64{1, 5, 3}, nil, true)
+	assert.Equal(t, "bin edges must be increasing and unique", err.Error(), "")
+	_, err = s.Cut([]float64{1, 3, 5}, []string{"a"}, true)
+	assert.Equal(t, "2 labels are required for 3 bin edges", err.Error(), "")
+	_, err = s.Cut([]float64{1, math.NaN(), 5}, nil, true)
+	assert.Equal(t, "bin edges must be finite", err.Error(), "")
+	_, err = s.Cut([]float64{math.Inf(-1), 3, 5}, nil, true)
+	assert.Equal(t, "bin edges must be finite", err.Error(), "")
+}
+
+func TestCutCategoricalData(t *testing.T) {
+	s := createTestCategoricalSeries()
+	_, err := s.Cut([]float64{1, 3, 5}, nil, true)
+	assert.Equal(t, "Series MySeries is categorical", err.Error(), "")
+}
+
+func TestQCut(t *testing.T) {
+	s := NewSeries("x", []float64{1, 2, 3, 4, 5, 6, 7, 8})
+	c, err := s.QCut(4)
+	assert.Equal(t, nil, err, "error is not nil")
+	h, _ := c.Hist()
+	assert.Equal(t, map[string]int{"[1, 2.75]": 2, "(2.75, 4.5]": 2, "(4.5, 6.25]": 2, "(6.25, 8]": 2}, h, "values are not evenly binned")
+}
+
+func TestQCutMissingValues(t *testing.T) {
+	s := NewSeries("x", []float64{math.NaN(), 1, 2, 3, 4})
+	c, err := s.QCut(2)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, math.IsNaN(c.Values[0]), "missing value should not be binned")
+	h, _ := c.Hist()
+	assert.Equal(t, map[string]int{"[1, 2.5]": 2, "(2.5, 4]": 2}, h, "values are not evenly binned")
+
+	c, err = NewSeries("x", []float64{1, 2, math.Inf(1), 3, 4}).QCut(2)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, math.IsNaN(c.Values[2]), "infinite value should not be binned")
+
+	_, err = NewSeries("x", []float64{math.NaN()}).QCut(2)
+	assert.Equal(t, "Series x has no values", err.Error(), "error is not correct")
+}
+
+func TestEqualWidthEdgesMissingValues(t *testing.T) {
+	s := NewSeries("x", []float64{math.NaN(), 0, 10})
+	assert.Equal(t, []float64{0, 5, 10}, s.equalWidthEdges(2), "edges are not correct")
+}
+
+func TestQCutDuplicateEdges(t *testing.T) {
+	s := NewSeries("x", []float64{1, 1, 1, 1, 2})
+	_, err := s.QCut(4)
+	assert.Equal(t, "bin edges must be increasing and unique", err.Error(), "")
+}
+
+func TestHistOfNumericData(t *testing.T) {
+	s := createTestSeries() // 0, 1, 1, 2, 3, 3, 4, 4, 7, 7
+	h, err := s.Hist(2)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, map[string]int{"[0, 3.5]": 6, "(3.5, 7]": 4}, h, "counts are not correct")
+}
+
+func TestHistOfNumericDataIncludesEmptyBins(t *testing.T) {
+	s := NewSeries("x", []float64{0, 1, 9, 10})
+	h, err := s.Hist(5)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 5, len(h), "empty bins are missing")
+	assert.Equal(t, 0, h["(4, 6]"], "empty bin count is not zero")
+}
@@ -0,0 +1,181 @@
+package gander
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression specifies the compression used when
+// writing a file.
+type Compression int
+
+const (
+	// CompressionNone writes the data without compression.
+	CompressionNone Compression = iota
+	// CompressionGzip compresses the data with gzip.
+	CompressionGzip
+	// CompressionZstd compresses the data with Zstandard.
+	CompressionZstd
+)
+
+// compression formats that can be read, including
+// those that cannot be written.
+const (
+	formatNone = iota
+	formatGzip
+	formatBzip2
+	formatZstd
+	formatZip
+)
+
+// compressionFormat identifies the compression of a file from its
+// first bytes, or from the extension of its name if they are not
+// recognised.
+func compressionFormat(magic []byte, name string) int {
+	switch {
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		return formatGzip
+	case bytes.HasPrefix(magic, []byte("BZh")):
+		return formatBzip2
+	case bytes.HasPrefix(magic, []byte{0x28, 0xb5, 0x2f, 0xfd}):
+		return formatZstd
+	case bytes.HasPrefix(magic, []byte("PK\x03\x04")):
+		return formatZip
+	}
+
+	switch strings.ToLower(path.Ext(name)) {
+	case ".gz", ".gzip":
+		return formatGzip
+	case ".bz2":
+		return formatBzip2
+	case ".zst", ".zstd":
+		return formatZstd
+	case ".zip":
+		return formatZip
+	}
+
+	return formatNone
+}
+
+// decompress returns a reader of the decompressed contents of r, which
+// may be gzip, bzip2, Zstandard or zip compressed. The file named member
+// is read from a zip archive, or if member is empty, the only file in the
+// archive. Zip archives are read into memory.
+func decompress(r io.Reader, name string, member string) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(4)
+
+	switch compressionFormat(magic, name) {
+	case formatGzip:
+		return gzip.NewReader(br)
+	case formatBzip2:
+		return ioutil.NopCloser(bzip2.NewReader(br)), nil
+	case formatZstd:
+		z, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return z.IOReadCloser(), nil
+	case formatZip:
+		return decompressZip(br, member)
+	}
+
+	if member != "" {
+		return nil, fmt.Errorf("%s is not a zip archive", name)
+	}
+
+	return ioutil.NopCloser(br), nil
+}
+
+func decompressZip(r io.Reader, member string) (io.ReadCloser, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	z, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		return nil, err
+	}
+
+	files := []*zip.File{}
+	for _, f := range z.File {
+		if f.FileInfo().IsDir() || strings.HasPrefix(f.Name, "__MACOSX/") {
+			continue
+		}
+		if member == "" || f.Name == member {
+			files = append(files, f)
+		}
+	}
+
+	if len(files) == 0 && member != "" {
+		return nil, fmt.Errorf("file '%s' does not exist in the zip archive", member)
+	}
+
+	if len(files) != 1 {
+		return nil, fmt.Errorf("zip archive contains %d files, so the file to load must be named", len(files))
+	}
+
+	f, err := files[0].Open()
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := decompress(f, files[0].Name, "")
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return readCloser{d, f}, nil
+}
+
+// readCloser reads from a decompressing reader, closing
+// both it and the underlying reader.
+type readCloser struct {
+	io.ReadCloser
+	inner io.Closer
+}
+
+func (r readCloser) Close() error {
+	err := r.ReadCloser.Close()
+	if e := r.inner.Close(); err == nil {
+		err = e
+	}
+
+	return err
+}
+
+// compressWriter returns a writer that compresses data written
+// to it and writes it to w. It must be closed to flush the
+// data, but does not close w.
+func compressWriter(w io.Writer, c Compression) (io.WriteCloser, error) {
+	switch c {
+	case CompressionNone:
+		return nopWriteCloser{w}, nil
+	case CompressionGzip:
+		return gzip.NewWriter(w), nil
+	case CompressionZstd:
+		return zstd.NewWriter(w)
+	}
+
+	return nil, fmt.Errorf("unknown compression %d", c)
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
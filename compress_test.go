@@ -0,0 +1,194 @@
+package gander
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeCompressedTestFile(t *testing.T, path string, c Compression) {
+	data, _ := ioutil.ReadFile("./testdata/MOCK_DATA.csv")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, _ := compressWriter(f, c)
+	w.Write(data)
+	w.Close()
+	f.Close()
+}
+
+func TestLoadCSVFromPathCompressed(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "gander")
+	defer os.RemoveAll(dir)
+
+	writeCompressedTestFile(t, filepath.Join(dir, "data.csv.gz"), CompressionGzip)
+	writeCompressedTestFile(t, filepath.Join(dir, "data.csv.zst"), CompressionZstd)
+	writeCompressedTestFile(t, filepath.Join(dir, "data.csv"), CompressionZstd)
+
+	for _, p := range []string{
+		"./testdata/MOCK_DATA.csv.bz2",
+		filepath.Join(dir, "data.csv.gz"),
+		filepath.Join(dir, "data.csv.zst"),
+		filepath.Join(dir, "data.csv"),
+	} {
+		df, err := LoadCSVFromPath(p)
+		assert.Equal(t, nil, err, "error is not nil")
+		assert.Equal(t, 6, len(*df), "dataframe does not have the correct number of columns")
+		assert.Equal(t, 1000, df.Rows(), "dataframe does not have the correct number of rows")
+	}
+}
+
+func TestCompressionFormat(t *testing.T) {
+	assert.Equal(t, formatGzip, compressionFormat([]byte{0x1f, 0x8b, 8, 0}, "a.csv"), "gzip magic bytes not detected")
+	assert.Equal(t, formatZip, compressionFormat([]byte("PK\x03\x04"), "a.csv"), "zip magic bytes not detected")
+	assert.Equal(t, formatBzip2, compressionFormat([]byte("a,b\n"), "a.csv.BZ2"), "bzip2 extension not detected")
+	assert.Equal(t, formatZstd, compressionFormat(nil, "a.csv.zst"), "zstd extension not detected")
+	assert.Equal(t, formatNone, compressionFormat([]byte("a,b\n"), "a.csv"), "uncompressed file not detected")
+}
+
+func createTestZip(t *testing.T, path string, files map[string][]byte) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := zip.NewWriter(f)
+	z.Create("dir/")
+	for n, b := range files {
+		w, _ := z.Create(n)
+		w.Write(b)
+	}
+	z.Close()
+	f.Close()
+}
+
+func TestLoadCSVFromZip(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "gander")
+	defer os.RemoveAll(dir)
+
+	gz := bytes.Buffer{}
+	w := gzip.NewWriter(&gz)
+	w.Write([]byte("c\n5\n6\n"))
+	w.Close()
+
+	one := filepath.Join(dir, "one.zip")
+	createTestZip(t, one, map[string][]byte{"a.csv": []byte("a,b\n1,2\n")})
+	two := filepath.Join(dir, "two.zip")
+	createTestZip(t, two, map[string][]byte{"a.csv": []byte("a,b\n1,2\n"), "c.csv.gz": gz.Bytes()})
+
+	df, err := LoadCSVFromPath(one)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"a", "b"}, df.ColumnNames(), "column names are not correct")
+
+	_, err = LoadCSVFromPath(two)
+	assert.Equal(t, "zip archive contains 2 files, so the file to load must be named", err.Error(), "error message is not correct")
+
+	df, err = LoadCSVFromZip(two, "c.csv.gz")
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []float64{5, 6}, (*df)[0].Values, "values are not correct")
+
+	_, err = LoadCSVFromZip(two, "d.csv")
+	assert.Equal(t, "file 'd.csv' does not exist in the zip archive", err.Error(), "error message is not correct")
+
+	_, err = LoadCSVFromZip("./testdata/MOCK_DATA.csv", "d.csv")
+	assert.Equal(t, "./testdata/MOCK_DATA.csv is not a zip archive", err.Error(), "error message is not correct")
+}
+
+func TestLoadCSVFromURLCompressed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/gzip")
+		c, _ := compressWriter(w, CompressionGzip)
+		fmt.Fprintln(c, "a,b")
+		fmt.Fprintln(c, "1,2")
+		c.Close()
+	}))
+	defer ts.Close()
+
+	df, err := LoadCSVFromURL(ts.URL + "/data.csv.gz")
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []float64{2}, (*df)[1].Values, "values are not correct")
+}
+
+func TestWriteCSV(t *testing.T) {
+	df := &DataFrame{
+		NewSeries("a", []float64{1.5, math.NaN()}),
+		NewCategoricalSeries("b,c", []string{"x", "y \"z\""}),
+	}
+
+	b := bytes.Buffer{}
+	err := df.WriteCSV(&b, CompressionNone)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, "a,\"b,c\"\n1.5,x\n,\"y \"\"z\"\"\"\n", b.String(), "csv is not correct")
+
+	for _, c := range []Compression{CompressionGzip, CompressionZstd} {
+		b := bytes.Buffer{}
+		err := df.WriteCSV(&b, c)
+		assert.Equal(t, nil, err, "error is not nil")
+
+		r, _ := decompress(&b, "", "")
+		data, _ := ioutil.ReadAll(r)
+		assert.Equal(t, "a,\"b,c\"\n1.5,x\n,\"y \"\"z\"\"\"\n", string(data), "decompressed csv is not correct")
+	}
+}
+
+func TestWriteCSVRoundTrip(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "gander")
+	defer os.RemoveAll(dir)
+	p := filepath.Join(dir, "data.csv")
+
+	b := NewCategoricalSeries("b", []string{"x", "x", "y"})
+	b.Values[1] = math.NaN()
+	df := &DataFrame{
+		NewSeries("a", []float64{1.5, math.NaN(), math.Inf(1)}),
+		b,
+		NewSeries("c", []float64{math.Inf(-1), 2, math.NaN()}),
+	}
+
+	f, _ := os.Create(p)
+	err := df.WriteCSV(f, CompressionNone)
+	f.Close()
+	assert.Equal(t, nil, err, "error is not nil")
+
+	r, err := LoadCSVFromPath(p)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"a", "b", "c"}, r.ColumnNames(), "column names are not correct")
+	assert.Equal(t, 1.5, (*r)[0].Values[0], "value is not correct")
+	assert.Equal(t, true, math.IsNaN((*r)[0].Values[1]), "missing value should be missing")
+	assert.Equal(t, math.Inf(1), (*r)[0].Values[2], "infinite value is not correct")
+	assert.Equal(t, true, (*r)[1].IsCategorical(), "categorical column should stay categorical")
+	assert.Equal(t, true, math.IsNaN((*r)[1].Values[1]), "missing label should be missing")
+	assert.Equal(t, "y", (*r)[1].label((*r)[1].Values[2]), "label is not correct")
+	assert.Equal(t, math.Inf(-1), (*r)[2].Values[0], "infinite value is not correct")
+	assert.Equal(t, 2.0, (*r)[2].Values[1], "value is not correct")
+	assert.Equal(t, true, math.IsNaN((*r)[2].Values[2]), "missing value should be missing")
+}
+
+func TestWriteJSON(t *testing.T) {
+	df := &DataFrame{
+		NewSeries("a", []float64{1.5, math.NaN()}),
+		NewCategoricalSeries("b", []string{"x", "\"y\""}),
+	}
+
+	b := bytes.Buffer{}
+	err := df.WriteJSON(&b, CompressionNone)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, "[\n{\"a\":1.5,\"b\":\"x\"},\n{\"a\":null,\"b\":\"\\\"y\\\"\"}\n]\n", b.String(), "json is not correct")
+
+	b.Reset()
+	err = df.WriteJSON(&b, CompressionGzip)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []byte{0x1f, 0x8b}, b.Bytes()[:2], "json should be gzip compressed")
+
+	err = df.WriteJSON(&b, Compression(9))
+	assert.Equal(t, "unknown compression 9", err.Error(), "error message is not correct")
+}
@@ -3,14 +3,24 @@ package gander
 import (
 	"errors"
 	"fmt"
-	"sort"
+	"math"
 	"strconv"
-	"sync"
 )
 
 // A DataFrame is a slice of *Series. As a Series
 // contains a slice of float64, a DataFrame can be thought of
 // as a two dimensional table of data, somewhat like a spreadsheet.
+//
+// Copying a DataFrame, or dropping columns from a copy, does not copy
+// its Series, so changes made through one DataFrame are seen by any
+// other that holds the same Series. Use Clone to make an independent
+// copy, or Snapshot to make one that cannot be changed.
+//
+// Methods that only read a DataFrame, such as Describe, String and the
+// writers, can be called from several goroutines at the same time.
+// Methods that change it, such as Standardize, DropRows and Transform,
+// must not run at the same time as any other method on the same
+// DataFrame, or on another DataFrame that shares its Series.
 type DataFrame []*Series
 
 // NewDataFrame creates a DataFrame from a 2 dimensional string slice, converting
@@ -18,7 +28,8 @@ type DataFrame []*Series
 // a float64, then the first row is treated as containing headers and is used to set
 // the column name of each Series. If a value (excluding values in the first row) cannot
 // be converted to a float64, then the Series is marked as holding categorical data
-// and will not be used for numeric calculations.
+// and will not be used for numeric calculations. Empty values are held as missing
+// (NaN) values, and do not make a Series categorical.
 func NewDataFrame(data [][]string) (*DataFrame, error) {
 	if !columnCountsMatch(data) {
 		return nil, errors.New("not all rows have the same number of columns")
@@ -35,11 +46,10 @@ func NewDataFrame(data [][]string) (*DataFrame, error) {
 		}
 	}
 
-	d := DataFrame{}
-	for x := 0; x < len(data[0]); x++ {
-		s := createSeries(headers[x], data, x)
-		d = append(d, s)
-	}
+	d := make(DataFrame, len(data[0]))
+	parallelFor(len(d), func(x int) {
+		d[x] = createSeries(headers[x], data, x)
+	})
 
 	return &d, nil
 }
@@ -47,36 +57,49 @@ func NewDataFrame(data [][]string) (*DataFrame, error) {
 // DropRows removes the rows specified by the provided row numbers.
 // Row numbers are zero based.
 func (d *DataFrame) DropRows(r ...int) error {
-	if maxInt(r) > d.Rows()-1 {
+	if maxInt(r) > d.Rows()-1 || minInt(r) < 0 {
 		return errors.New("a specified row is out of range")
 	}
 
-	if sort.IntsAreSorted(r) == false {
-		sort.Ints(r)
+	keep := make([]bool, d.Rows())
+	for i := range keep {
+		keep[i] = true
 	}
 
-	sort.Sort(sort.Reverse(sort.IntSlice(r)))
-
 	for _, v := range r {
-		d.dropRow(v)
+		keep[v] = false
 	}
 
+	d.keepRows(keep)
+
 	return nil
 }
 
 // DropRowsWhere removes all the rows where the provided function
 // evaluates to true.
 func (d *DataFrame) DropRowsWhere(fn func([]float64) bool) error {
-	for i := d.Rows() - 1; i >= 0; i-- {
-		r := d.toRow(i)
-		if fn(r) == true {
-			d.dropRow(i)
-		}
+	keep := make([]bool, d.Rows())
+
+	for i := range keep {
+		keep[i] = fn(d.toRow(i)) == false
 	}
 
+	d.keepRows(keep)
+
 	return nil
 }
 
+// Clone returns a deep copy of the DataFrame. The copy shares no
+// Series with d, so either can be modified without affecting the other.
+func (d *DataFrame) Clone() *DataFrame {
+	df := make(DataFrame, d.Columns())
+	parallelFor(len(df), func(i int) {
+		df[i] = (*d)[i].Clone()
+	})
+
+	return &df
+}
+
 // DropColumns removes the columns specified by the provided column numbers.
 // Column numbers are zero based.
 func (d *DataFrame) DropColumns(r ...int) error {
@@ -132,6 +155,73 @@ func (d *DataFrame) DropColumnsWhere(fn func(*Series) bool) error {
 	return nil
 }
 
+// Keep specifies which occurrence of a duplicated row is
+// not marked as a duplicate.
+type Keep int
+
+const (
+	// KeepFirst marks all occurrences except the first as duplicates.
+	KeepFirst Keep = iota
+	// KeepLast marks all occurrences except the last as duplicates.
+	KeepLast
+	// KeepNone marks all occurrences as duplicates.
+	KeepNone
+)
+
+// Duplicated returns a slice with an entry for each row, which is true
+// if the row is a duplicate of another row. Only the columns named in
+// subset are compared, or all columns if subset is empty.
+func (d *DataFrame) Duplicated(subset []string, keep Keep) ([]bool, error) {
+	cols, err := d.seriesByName(subset...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(subset) == 0 {
+		cols = *d
+	}
+
+	keys := make([]string, d.Rows())
+	c := map[string]int{}
+
+	for i := range keys {
+		keys[i] = rowKey(cols, i)
+		c[keys[i]] += 1
+	}
+
+	r := make([]bool, d.Rows())
+	seen := map[string]bool{}
+
+	switch keep {
+	case KeepFirst:
+		for i, k := range keys {
+			r[i] = seen[k]
+			seen[k] = true
+		}
+	case KeepLast:
+		for i := len(keys) - 1; i >= 0; i-- {
+			r[i] = seen[keys[i]]
+			seen[keys[i]] = true
+		}
+	default:
+		for i, k := range keys {
+			r[i] = c[k] > 1
+		}
+	}
+
+	return r, nil
+}
+
+// DropDuplicates removes duplicated rows, as identified by Duplicated.
+func (d *DataFrame) DropDuplicates(subset []string, keep Keep) error {
+	dup, err := d.Duplicated(subset, keep)
+	if err != nil {
+		return err
+	}
+
+	return d.dropRowsMask(dup)
+}
+
 // ColumnNames returns a slice of the column names in the DataFrame.
 func (d *DataFrame) ColumnNames() []string {
 	cols := []string{}
@@ -153,62 +243,38 @@ func (d *DataFrame) Rows() int {
 	return len((*d)[0].Values)
 }
 
-// String returns a tabular representation of the DataFrame.
+// String returns a tabular representation of the DataFrame, laid out
+// using the options set by SetFormatOptions. By default the first 10 rows
+// are shown, with values to 2 decimal places.
 func (d *DataFrame) String() string {
-	df := *d
-	columns := len(df)
-	colWidths := []int{}
-	output := ""
-
-	for c := 0; c < columns; c++ {
-		cl := len(df[c].Name) + 2 // add 2 for padding
-		if cl < 12 {
-			cl = 12
-		}
-		colWidths = append(colWidths, cl)
-		output += fmt.Sprintf("%"+strconv.Itoa(cl)+"v", fmt.Sprintf("%v  ", df[c].Name))
-	}
-
-	output += fmt.Sprintf("\n")
-
-	rows := len(df[0].Values)
-	if rows > 10 {
-		rows = 10 // only print 1st 10 rows
-	}
-
-	for r := 0; r < rows; r++ {
-		for c := 0; c < columns; c++ {
-			if df[c].IsCategorical() == true {
-				output += fmt.Sprintf(" %"+strconv.Itoa(colWidths[c]-3)+"s  ", df[c].categoricalLabels[df[c].Values[r]])
-			} else {
-				output += fmt.Sprintf(" %"+strconv.Itoa(colWidths[c]-3)+".2f  ", df[c].Values[r])
-			}
-		}
-		output += fmt.Sprintf("\n")
-	}
-
-	return output
+	return d.Format(GetFormatOptions())
 }
 
 // Standardize scales the values in all non-categorical Series
 // to standard form.
 func (d *DataFrame) Standardize() {
-	for _, v := range *d {
+	d.forEachSeries(func(v *Series) {
 		if v.IsCategorical() == false {
 			v.Standardize()
 		}
-	}
+	})
 }
 
 // Describe returns a summary of the statisical properties
 // of all the Series in the DataFrame.
 func (d *DataFrame) Describe() []Summary {
-	s := []Summary{}
+	r := make([]*Summary, d.Columns())
+	parallelFor(d.Columns(), func(i int) {
+		if (*d)[i].IsCategorical() == false {
+			vs := (*d)[i].Describe()
+			r[i] = &vs
+		}
+	})
 
-	for _, v := range *d {
-		if v.IsCategorical() == false {
-			vs := v.Describe()
-			s = append(s, vs)
+	s := []Summary{}
+	for _, v := range r {
+		if v != nil {
+			s = append(s, *v)
 		}
 	}
 
@@ -225,17 +291,89 @@ func (d *DataFrame) toRow(i int) []float64 {
 	return r
 }
 
-func (d *DataFrame) dropRow(r int) {
-	var wg sync.WaitGroup
-	wg.Add(d.Columns())
+// takeRows returns a new DataFrame made up of the provided rows,
+// in the order they are given.
+func (d *DataFrame) takeRows(r []int) *DataFrame {
+	df := make(DataFrame, d.Columns())
+
+	parallelFor(d.Columns(), func(c int) {
+		v := (*d)[c]
+		s := v.emptyCopy()
+		s.Values = make([]float64, len(r))
+		for i, x := range r {
+			s.Values[i] = v.Values[x]
+		}
+		df[c] = s
+	})
+
+	return &df
+}
+
+// seriesByName returns the Series with the provided names, in the
+// order they are given.
+func (d *DataFrame) seriesByName(n ...string) (DataFrame, error) {
+	df := DataFrame{}
+
+	for _, c := range n {
+		found := false
+		for _, v := range *d {
+			if v.Name == c {
+				df = append(df, v)
+				found = true
+				break
+			}
+		}
+		if found == false {
+			return nil, fmt.Errorf("column '%s' does not exist in the DataFrame", c)
+		}
+	}
+
+	return df, nil
+}
+
+func rowKey(d DataFrame, i int) string {
+	k := make([]byte, 0, len(d)*8)
 
-	for i, _ := range *d {
-		go func(s *Series) {
-			defer wg.Done()
-			s.dropRow(r)
-		}((*d)[i])
+	for _, v := range d {
+		k = strconv.AppendUint(k, math.Float64bits(v.Values[i]), 16)
+		k = append(k, ',')
 	}
-	wg.Wait()
+
+	return string(k)
+}
+
+// dropRowsMask removes the rows where the mask is true.
+func (d *DataFrame) dropRowsMask(m []bool) error {
+	if len(m) != d.rowCount() {
+		return errors.New("the mask does not have an entry for each row")
+	}
+
+	keep := make([]bool, len(m))
+	for i, v := range m {
+		keep[i] = v == false
+	}
+
+	d.keepRows(keep)
+
+	return nil
+}
+
+// keepRows removes the rows where the mask is false, compacting the
+// values of each Series in a single pass. The Series are compacted
+// concurrently, as parallelFor allows.
+func (d *DataFrame) keepRows(keep []bool) {
+	all := true
+	for _, v := range keep {
+		all = all && v
+	}
+
+	if all {
+		return
+	}
+
+	d.forEachSeries(func(s *Series) {
+		s.keepRows(keep)
+	})
 }
 
 func maxInt(l []int) int {
@@ -250,6 +388,18 @@ func maxInt(l []int) int {
 	return m
 }
 
+func minInt(l []int) int {
+	m := 0
+
+	for _, v := range l {
+		if v < m {
+			m = v
+		}
+	}
+
+	return m
+}
+
 func containsInt(i int, l []int) bool {
 	// TODO: make more efficient
 	for _, v := range l {
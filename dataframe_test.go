@@ -2,6 +2,8 @@ package gander
 
 import (
 	"github.com/stretchr/testify/assert"
+	"math"
+	"sync"
 	"testing"
 )
 
@@ -129,6 +131,21 @@ func TestDropRowsOutOfRange(t *testing.T) {
 	assert.Equal(t, "a specified row is out of range", err.Error(), "error message is not correct")
 }
 
+func TestDropRowsNegative(t *testing.T) {
+	df, err := NewDataFrame(createSampleDataWithHeaders())
+	assert.Equal(t, nil, err, "error is not nil")
+	err = df.DropRows(-1)
+	assert.Equal(t, "a specified row is out of range", err.Error(), "error message is not correct")
+}
+
+func TestDropRowsRepeatedRow(t *testing.T) {
+	df, err := NewDataFrame(createSampleDataWithHeaders())
+	assert.Equal(t, nil, err, "error is not nil")
+	df.DropRows(1, 1)
+	assert.Equal(t, 3, df.Rows(), "wrong number of rows remaining")
+	assert.Equal(t, []float64{1, 7, 4}, (*df)[0].Values, "wrong rows remaining")
+}
+
 func TestDropRowsWhereSingleRow(t *testing.T) {
 	df, err := NewDataFrame(createSampleDataWithHeaders())
 	assert.Equal(t, nil, err, "error is not nil")
@@ -321,3 +338,165 @@ func TestStandardizeDataFrame(t *testing.T) {
 		}
 	}
 }
+
+func TestDescribeWithBlankValues(t *testing.T) {
+	df, err := NewDataFrame([][]string{
+		{"a", "b"},
+		{"1", "2"},
+		{"", "4"},
+		{"5", "6"},
+	})
+	assert.Equal(t, nil, err, "error is not nil")
+
+	s := df.Describe()
+	assert.Equal(t, 3.0, s[0].Mean, "mean is not correct")
+	assert.Equal(t, 3.0, s[0].Median, "median is not correct")
+	assert.Equal(t, 1.0, s[0].Min, "minimum is not correct")
+	assert.Equal(t, 5.0, s[0].Max, "maximum is not correct")
+	assert.Equal(t, 2, len(s[0].Mode), "mode should not include the blank value")
+
+	df.Standardize()
+	assert.Equal(t, -1.0, (*df)[0].Values[0], "value is not standardized")
+	assert.Equal(t, true, math.IsNaN((*df)[0].Values[1]), "blank value should stay missing")
+	assert.Equal(t, 1.0, (*df)[0].Values[2], "value is not standardized")
+}
+
+func createSampleDataWithDuplicates() [][]string {
+	return [][]string{
+		{"a", "b", "c"},
+		{"1", "x", "3"},
+		{"2", "y", "4"},
+		{"1", "x", "3"},
+		{"1", "x", "5"},
+		{"1", "x", "3"},
+	}
+}
+
+func TestDuplicatedKeepFirst(t *testing.T) {
+	df, err := NewDataFrame(createSampleDataWithDuplicates())
+	assert.Equal(t, nil, err, "error is not nil")
+	d, err := df.Duplicated(nil, KeepFirst)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []bool{false, false, true, false, true}, d, "duplicates not identified correctly")
+}
+
+func TestDuplicatedKeepLast(t *testing.T) {
+	df, err := NewDataFrame(createSampleDataWithDuplicates())
+	assert.Equal(t, nil, err, "error is not nil")
+	d, err := df.Duplicated(nil, KeepLast)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []bool{true, false, true, false, false}, d, "duplicates not identified correctly")
+}
+
+func TestDuplicatedKeepNoneWithSubset(t *testing.T) {
+	df, err := NewDataFrame(createSampleDataWithDuplicates())
+	assert.Equal(t, nil, err, "error is not nil")
+	d, err := df.Duplicated([]string{"a", "b"}, KeepNone)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []bool{true, false, true, true, true}, d, "duplicates not identified correctly")
+}
+
+func TestDuplicatedInvalidName(t *testing.T) {
+	df, err := NewDataFrame(createSampleDataWithDuplicates())
+	assert.Equal(t, nil, err, "error is not nil")
+	_, err = df.Duplicated([]string{"f"}, KeepFirst)
+	assert.Equal(t, "column 'f' does not exist in the DataFrame", err.Error(), "")
+}
+
+func TestDropDuplicates(t *testing.T) {
+	df, err := NewDataFrame(createSampleDataWithDuplicates())
+	assert.Equal(t, nil, err, "error is not nil")
+	err = df.DropDuplicates(nil, KeepFirst)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 3, df.Rows(), "wrong number of rows remaining")
+	assert.Equal(t, []float64{3, 4, 5}, (*df)[2].Values, "wrong rows removed")
+}
+
+func TestDataFrameClone(t *testing.T) {
+	df, err := NewDataFrame(createSampleDataWithHeaders())
+	assert.Equal(t, nil, err, "error is not nil")
+	c := df.Clone()
+	assert.Equal(t, df, c, "clone is not equal to the original DataFrame")
+
+	c.Standardize()
+	c.DropRows(0)
+	assert.Equal(t, 4, df.Rows(), "original DataFrame rows were changed")
+	assert.Equal(t, []float64{1, 3, 7, 4}, (*df)[0].Values, "original DataFrame values were changed")
+}
+
+func TestDropColumnsSharesSeries(t *testing.T) {
+	df, err := NewDataFrame(createSampleDataWithHeaders())
+	assert.Equal(t, nil, err, "error is not nil")
+	c := *df
+	c.DropColumns(1)
+	c.Standardize()
+	assert.Equal(t, (*df)[0].Values, c[0].Values, "copied DataFrame should share Series with the original")
+	assert.Equal(t, []float64{2, 5, 6, 2}, (*df)[1].Values, "dropped Series should not be changed")
+}
+
+func createBenchmarkDataFrame(rows int, cols int) *DataFrame {
+	df := DataFrame{}
+
+	for c := 0; c < cols; c++ {
+		v := make([]float64, rows)
+		for i := range v {
+			v[i] = float64(i)
+		}
+		df = append(df, NewSeries(string(rune('a'+c)), v))
+	}
+
+	return &df
+}
+
+// dropRowsOneByOne removes rows in the way DropRows did before it used a
+// keep mask, with a copy and a goroutine per column for every dropped row.
+func dropRowsOneByOne(d *DataFrame, r []int) {
+	for i := len(r) - 1; i >= 0; i-- {
+		var wg sync.WaitGroup
+		wg.Add(d.Columns())
+		for _, s := range *d {
+			go func(s *Series) {
+				defer wg.Done()
+				s.Values = append(s.Values[:r[i]], s.Values[r[i]+1:]...)
+			}(s)
+		}
+		wg.Wait()
+	}
+}
+
+func benchmarkDropRows(b *testing.B, drop int, fn func(*DataFrame, []int)) {
+	r := []int{}
+	for i := 0; i < drop; i++ {
+		r = append(r, i*(1000000/drop))
+	}
+
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		df := createBenchmarkDataFrame(1000000, 8)
+		b.StartTimer()
+		fn(df, r)
+	}
+}
+
+func BenchmarkDropRows1MRows100Dropped(b *testing.B) {
+	benchmarkDropRows(b, 100, func(d *DataFrame, r []int) { d.DropRows(r...) })
+}
+
+func BenchmarkDropRowsOneByOne1MRows100Dropped(b *testing.B) {
+	benchmarkDropRows(b, 100, dropRowsOneByOne)
+}
+
+func BenchmarkDropRows1MRows100000Dropped(b *testing.B) {
+	benchmarkDropRows(b, 100000, func(d *DataFrame, r []int) { d.DropRows(r...) })
+}
+
+func BenchmarkDropRowsWhere1MRowsHalfDropped(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		df := createBenchmarkDataFrame(1000000, 8)
+		b.StartTimer()
+		df.DropRowsWhere(func(r []float64) bool {
+			return int(r[0])%2 == 0
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package gander
+
+import (
+	"math"
+)
+
+// studentTPValue returns the two-sided p-value of the statistic t
+// for Student's t distribution with df degrees of freedom.
+func studentTPValue(t float64, df float64) float64 {
+	if math.IsNaN(t) || df <= 0 {
+		return math.NaN()
+	}
+
+	if math.IsInf(t, 0) {
+		return 0
+	}
+
+	return regIncBeta(df/2, 0.5, df/(df+t*t))
+}
+
+// regIncBeta returns the regularized incomplete beta function I_x(a, b).
+func regIncBeta(a, b, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+
+	if x >= 1 {
+		return 1
+	}
+
+	la, _ := math.Lgamma(a)
+	lb, _ := math.Lgamma(b)
+	lab, _ := math.Lgamma(a + b)
+	front := math.Exp(lab - la - lb + a*math.Log(x) + b*math.Log(1-x))
+
+	// the continued fraction converges rapidly for x < (a+1)/(a+b+2),
+	// otherwise use the symmetry relation I_x(a, b) = 1 - I_1-x(b, a)
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(a, b, x) / a
+	}
+
+	return 1 - front*betaContinuedFraction(b, a, 1-x)/b
+}
+
+// betaContinuedFraction evaluates the continued fraction for the
+// incomplete beta function using the modified Lentz method.
+func betaContinuedFraction(a, b, x float64) float64 {
+	const (
+		maxIterations = 300
+		epsilon       = 1e-15
+		tiny          = 1e-300
+	)
+
+	c := 1.0
+	d := 1 - (a+b)*x/(a+1)
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+
+	for m := 1; m <= maxIterations; m++ {
+		fm := float64(m)
+
+		// even step
+		n := fm * (b - fm) * x / ((a + 2*fm - 1) * (a + 2*fm))
+		d = 1 + n*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + n/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+
+		// odd step
+		n = -(a + fm) * (a + b + fm) * x / ((a + 2*fm) * (a + 2*fm + 1))
+		d = 1 + n*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + n/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+
+		if math.Abs(delta-1) < epsilon {
+			break
+		}
+	}
+
+	return h
+}
+
+// fPValue returns the probability of a value greater than f for the
+// F distribution with d1 and d2 degrees of freedom.
+func fPValue(f float64, d1, d2 float64) float64 {
+	if math.IsNaN(f) || d1 <= 0 || d2 <= 0 {
+		return math.NaN()
+	}
+
+	if f <= 0 {
+		return 1
+	}
+
+	return regIncBeta(d2/2, d1/2, d2/(d2+d1*f))
+}
+
+// chiSquarePValue returns the probability of a value greater than x
+// for the chi-square distribution with df degrees of freedom.
+func chiSquarePValue(x float64, df float64) float64 {
+	if math.IsNaN(x) || df <= 0 {
+		return math.NaN()
+	}
+
+	if x <= 0 {
+		return 1
+	}
+
+	return regIncGammaUpper(df/2, x/2)
+}
+
+// normalPValue returns the two-sided p-value of the statistic z
+// for the standard normal distribution.
+func normalPValue(z float64) float64 {
+	return math.Erfc(math.Abs(z) / math.Sqrt2)
+}
+
+// regIncGammaUpper returns the regularized upper incomplete
+// gamma function Q(a, x).
+func regIncGammaUpper(a, x float64) float64 {
+	const (
+		maxIterations = 500
+		epsilon       = 1e-15
+		tiny          = 1e-300
+	)
+
+	lga, _ := math.Lgamma(a)
+	front := math.Exp(-x + a*math.Log(x) - lga)
+
+	if x < a+1 {
+		// series for the lower function P(a, x)
+		ap := a
+		t := 1 / a
+		total := t
+		for n := 0; n < maxIterations; n++ {
+			ap++
+			t *= x / ap
+			total += t
+			if math.Abs(t) < math.Abs(total)*epsilon {
+				break
+			}
+		}
+		return 1 - total*front
+	}
+
+	// continued fraction for Q(a, x) using the modified Lentz method
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for i := 1; i <= maxIterations; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < epsilon {
+			break
+		}
+	}
+
+	return front * h
+}
@@ -10,4 +10,10 @@
 // Each column of the DataFrame is held as a Series object, which is made up of a
 // slice of float64s, and the name of the column. Categorical (non-numeric) data
 // can also be held in a Series, but no calculations can be carried out on it.
+// Missing values are held as NaN, and can be filled in using FFill, BFill
+// or Interpolate.
+//
+// A DataFrame can be read from several goroutines at the same time, but must
+// not be changed while it is being used elsewhere. Clone makes an independent
+// copy of a DataFrame, and Snapshot makes a copy that cannot be changed.
 package gander
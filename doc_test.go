@@ -79,3 +79,17 @@ func ExampleDataFrame_DropColumnsWhere() {
 	fmt.Printf("%v\n", df.Columns())
 	// Output: 4
 }
+
+func ExampleDataFrame_DropDuplicates() {
+	df, _ := NewDataFrame(
+		[][]string{
+			{"a", "b", "c"},
+			{"1", "x", "3"},
+			{"2", "y", "4"},
+			{"1", "x", "3"},
+			{"1", "x", "5"},
+		})
+	df.DropDuplicates([]string{"a", "b"}, KeepFirst)
+	fmt.Printf("%v\n", df.Rows())
+	// Output: 2
+}
@@ -0,0 +1,165 @@
+package gander
+
+import (
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// HTMLOptions control how a DataFrame is written as an HTML table.
+type HTMLOptions struct {
+	FormatOptions
+	// TableClass is the CSS class of the table element, if not empty.
+	TableClass string
+	// NAClass is the CSS class of cells holding missing values, if not empty.
+	NAClass string
+}
+
+var latexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	"&", `\&`,
+	"%", `\%`,
+	"$", `\$`,
+	"#", `\#`,
+	"_", `\_`,
+	"{", `\{`,
+	"}", `\}`,
+	"~", `\textasciitilde{}`,
+	"^", `\textasciicircum{}`,
+)
+
+// ToMarkdown returns the DataFrame as a Markdown table, with its columns
+// formatted in the same way as Format. The columns are aligned as set by
+// o.Align, and by default numeric columns are right aligned and
+// categorical columns are left aligned.
+func (d *DataFrame) ToMarkdown(o FormatOptions) string {
+	t := d.formatTable(o)
+
+	row := func(r []string) string {
+		for i := range r {
+			r[i] = strings.Replace(r[i], "|", `\|`, -1)
+		}
+		return "| " + strings.Join(r, " | ") + " |\n"
+	}
+
+	rules := []string{}
+	for _, a := range t.align {
+		switch a {
+		case 'l':
+			rules = append(rules, ":---")
+		case 'c':
+			rules = append(rules, ":---:")
+		default:
+			rules = append(rules, "---:")
+		}
+	}
+
+	output := row(t.headers)
+	output += "| " + strings.Join(rules, " | ") + " |\n"
+	for _, r := range t.cells {
+		output += row(r)
+	}
+
+	return output
+}
+
+// ToHTML returns the DataFrame as an HTML table, with its columns
+// formatted in the same way as Format. All text is escaped.
+func (d *DataFrame) ToHTML(o HTMLOptions) string {
+	t := d.formatTable(o.FormatOptions)
+
+	output := "<table>\n"
+	if o.TableClass != "" {
+		output = `<table class="` + html.EscapeString(o.TableClass) + "\">\n"
+	}
+
+	output += "<thead>\n<tr>"
+	for _, h := range t.headers {
+		output += "<th>" + html.EscapeString(h) + "</th>"
+	}
+	output += "</tr>\n</thead>\n<tbody>\n"
+
+	for i, r := range t.cells {
+		output += "<tr>"
+		for j, c := range r {
+			switch {
+			case t.index == true && j == 0:
+				output += "<th>" + html.EscapeString(c) + "</th>"
+			case t.na[i][j] == true && o.NAClass != "":
+				output += `<td class="` + html.EscapeString(o.NAClass) + `">` + html.EscapeString(c) + "</td>"
+			default:
+				output += "<td>" + html.EscapeString(c) + "</td>"
+			}
+		}
+		output += "</tr>\n"
+	}
+
+	output += "</tbody>\n</table>\n"
+
+	return output
+}
+
+// ToLaTeX returns the DataFrame as a LaTeX tabular environment using the
+// rules from the booktabs package, with its columns formatted in the same
+// way as Format. All text is escaped.
+func (d *DataFrame) ToLaTeX(o FormatOptions) string {
+	t := d.formatTable(o)
+
+	row := func(r []string) string {
+		e := []string{}
+		for _, c := range r {
+			e = append(e, latexEscaper.Replace(c))
+		}
+		return strings.Join(e, " & ") + ` \\` + "\n"
+	}
+
+	output := `\begin{tabular}{` + string(t.align) + "}\n"
+	output += `\toprule` + "\n"
+	output += row(t.headers)
+	output += `\midrule` + "\n"
+	for _, r := range t.cells {
+		output += row(r)
+	}
+	output += `\bottomrule` + "\n"
+	output += `\end{tabular}` + "\n"
+
+	return output
+}
+
+// NewSummaryDataFrame creates a DataFrame from a slice of Summary, such
+// as that returned by Describe, with one row for each Summary. This allows
+// summaries to be displayed and exported in the same way as a DataFrame.
+// As a Series can have more than one mode, the modes are held as text,
+// in increasing order.
+func NewSummaryDataFrame(s []Summary) *DataFrame {
+	names, modes := []string{}, []string{}
+	cols := make([][]float64, 6)
+
+	for _, v := range s {
+		names = append(names, v.Name)
+
+		mode := append([]float64{}, v.Mode...)
+		sort.Float64s(mode)
+		m := []string{}
+		for _, x := range mode {
+			m = append(m, strconv.FormatFloat(x, 'g', -1, 64))
+		}
+		modes = append(modes, strings.Join(m, ", "))
+
+		for i, x := range []float64{v.Mean, v.Median, v.Min, v.Max, v.StdDev, v.Variance} {
+			cols[i] = append(cols[i], x)
+		}
+	}
+
+	return &DataFrame{
+		NewCategoricalSeries("Name", names),
+		NewSeries("Mean", cols[0]),
+		NewSeries("Median", cols[1]),
+		NewCategoricalSeries("Mode", modes),
+		NewSeries("Min", cols[2]),
+		NewSeries("Max", cols[3]),
+		NewSeries("StdDev", cols[4]),
+		NewSeries("Variance", cols[5]),
+	}
+}
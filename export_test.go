@@ -0,0 +1,84 @@
+package gander
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createExportTestData() *DataFrame {
+	return &DataFrame{
+		NewSeries("a_b", []float64{1, math.NaN()}),
+		NewCategoricalSeries("c", []string{"x|y", "<b>&"}),
+	}
+}
+
+func TestToMarkdown(t *testing.T) {
+	e := `| a_b | c |
+| ---: | :--- |
+| 1.00 | x\|y |
+| NaN | <b>& |
+`
+	assert.Equal(t, e, createExportTestData().ToMarkdown(DefaultFormatOptions()), "markdown is not correct")
+}
+
+func TestToMarkdownElided(t *testing.T) {
+	df, _ := NewDataFrame(createLargerSampleData())
+	o := DefaultFormatOptions()
+	o.MaxRows = 2
+	o.Elide = true
+	o.MaxColumns = 2
+	o.RowNumbers = true
+	e := `|  | a | ... | e |
+| ---: | ---: | :---: | ---: |
+| 0 | 1.00 | ... | 5.00 |
+| ... | ... | ... | ... |
+| 15 | 4.00 | ... | 6.00 |
+`
+	assert.Equal(t, e, df.ToMarkdown(o), "markdown is not correct")
+}
+
+func TestToHTML(t *testing.T) {
+	o := HTMLOptions{FormatOptions: DefaultFormatOptions(), TableClass: "data", NAClass: "na"}
+	o.RowNumbers = true
+	e := `<table class="data">
+<thead>
+<tr><th></th><th>a_b</th><th>c</th></tr>
+</thead>
+<tbody>
+<tr><th>0</th><td>1.00</td><td>x|y</td></tr>
+<tr><th>1</th><td class="na">NaN</td><td>&lt;b&gt;&amp;</td></tr>
+</tbody>
+</table>
+`
+	assert.Equal(t, e, createExportTestData().ToHTML(o), "html is not correct")
+}
+
+func TestToLaTeX(t *testing.T) {
+	e := `\begin{tabular}{rl}
+\toprule
+a\_b & c \\
+\midrule
+1.00 & x|y \\
+NaN & <b>\& \\
+\bottomrule
+\end{tabular}
+`
+	assert.Equal(t, e, createExportTestData().ToLaTeX(DefaultFormatOptions()), "latex is not correct")
+}
+
+func TestNewSummaryDataFrame(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithCategoricalData())
+	s := NewSummaryDataFrame(df.Describe())
+	assert.Equal(t, 4, s.Rows(), "wrong number of rows")
+	assert.Equal(t, []string{"Name", "Mean", "Median", "Mode", "Min", "Max", "StdDev", "Variance"}, s.ColumnNames(), "wrong columns")
+	e := `| Name | Mean | Median | Mode | Min | Max | StdDev | Variance |
+| :--- | ---: | ---: | :--- | ---: | ---: | ---: | ---: |
+| a | 3.75 | 3.50 | 1, 3, 4, 7 | 1.00 | 7.00 | 2.17 | 4.69 |
+| b | 3.75 | 3.50 | 2 | 2.00 | 6.00 | 1.79 | 3.19 |
+| c | 2.50 | 2.50 | 1, 2, 3, 4 | 1.00 | 4.00 | 1.12 | 1.25 |
+| e | 4.50 | 4.50 | 3, 4, 5, 6 | 3.00 | 6.00 | 1.12 | 1.25 |
+`
+	assert.Equal(t, e, s.ToMarkdown(DefaultFormatOptions()), "summary markdown is not correct")
+}
@@ -0,0 +1,226 @@
+package gander
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// An Interpolation is a method of estimating missing values.
+type Interpolation int
+
+const (
+	// InterpolateLinear estimates missing values by drawing a straight line
+	// between the neighbouring values, treating rows as evenly spaced.
+	InterpolateLinear Interpolation = iota
+	// InterpolateNearest replaces missing values with the nearest value.
+	InterpolateNearest
+	// InterpolateTime estimates missing values in the same way as
+	// InterpolateLinear, but weights them by the times held in another Series.
+	InterpolateTime
+)
+
+// timeLayouts are the formats accepted for the labels of a
+// categorical Series of times.
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02"}
+
+// FFill replaces missing (NaN) values with the last value before them
+// that is not missing. If limit is greater than zero, at most limit
+// consecutive missing values are replaced.
+func (s *Series) FFill(limit int) {
+	last := math.NaN()
+	n := 0
+
+	for i, v := range s.Values {
+		if isNA(v) == false {
+			last = v
+			n = 0
+			continue
+		}
+
+		n++
+		if limit <= 0 || n <= limit {
+			s.Values[i] = last
+		}
+	}
+}
+
+// BFill replaces missing (NaN) values with the first value after them
+// that is not missing. If limit is greater than zero, at most limit
+// consecutive missing values are replaced.
+func (s *Series) BFill(limit int) {
+	next := math.NaN()
+	n := 0
+
+	for i := len(s.Values) - 1; i >= 0; i-- {
+		if isNA(s.Values[i]) == false {
+			next = s.Values[i]
+			n = 0
+			continue
+		}
+
+		n++
+		if limit <= 0 || n <= limit {
+			s.Values[i] = next
+		}
+	}
+}
+
+// Interpolate replaces missing (NaN) values that lie between two values
+// that are not missing, using the provided method. Missing values at the
+// start or end of the Series are left unchanged. The times Series is only
+// used by InterpolateTime, and holds the time of each value, either as a
+// number such as a Unix timestamp, or as categorical dates and times in
+// RFC 3339, "2006-01-02 15:04:05" or "2006-01-02" format. The times must
+// be increasing and must not contain missing values.
+func (s *Series) Interpolate(method Interpolation, times *Series) error {
+	if s.IsCategorical() == true {
+		return fmt.Errorf("Series %s is categorical", s.Name)
+	}
+
+	x := make([]float64, len(s.Values))
+	for i := range x {
+		x[i] = float64(i)
+	}
+
+	if method == InterpolateTime {
+		if times == nil {
+			return errors.New("time interpolation requires a Series of times")
+		}
+		if len(times.Values) != len(s.Values) {
+			return errors.New("Series of times is not the same length")
+		}
+		t, err := times.times()
+		if err != nil {
+			return err
+		}
+		x = t
+	}
+
+	lo := -1
+	for hi, v := range s.Values {
+		if isNA(v) {
+			continue
+		}
+
+		if lo >= 0 && hi-lo > 1 {
+			for i := lo + 1; i < hi; i++ {
+				s.Values[i] = interpolate(method, x[lo], s.Values[lo], x[hi], s.Values[hi], x[i])
+			}
+		}
+
+		lo = hi
+	}
+
+	return nil
+}
+
+// times returns the values of a Series of times, parsing the labels
+// of a categorical Series as dates and times.
+func (s *Series) times() ([]float64, error) {
+	r := make([]float64, len(s.Values))
+
+	for i, v := range s.Values {
+		if isNA(v) {
+			return nil, errors.New("Series of times contains missing values")
+		}
+
+		r[i] = v
+		if s.IsCategorical() == true {
+			t, err := parseTime(s.label(v))
+			if err != nil {
+				return nil, fmt.Errorf("Series %s holds '%s', which is not a time", s.Name, s.label(v))
+			}
+			r[i] = float64(t.UnixNano()) / 1e9
+		}
+
+		if i > 0 && r[i] <= r[i-1] {
+			return nil, errors.New("Series of times is not increasing")
+		}
+	}
+
+	return r, nil
+}
+
+func parseTime(v string) (time.Time, error) {
+	var err error
+
+	for _, l := range timeLayouts {
+		var t time.Time
+		t, err = time.Parse(l, v)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
+
+// FFill forward fills missing values in all non-categorical Series.
+func (d *DataFrame) FFill(limit int) {
+	d.forEachSeries(func(v *Series) {
+		if v.IsCategorical() == false {
+			v.FFill(limit)
+		}
+	})
+}
+
+// BFill backward fills missing values in all non-categorical Series.
+func (d *DataFrame) BFill(limit int) {
+	d.forEachSeries(func(v *Series) {
+		if v.IsCategorical() == false {
+			v.BFill(limit)
+		}
+	})
+}
+
+// Interpolate interpolates missing values in all non-categorical Series.
+// For InterpolateTime, timeColumn names the column holding the time
+// of each row, which is not itself interpolated.
+func (d *DataFrame) Interpolate(method Interpolation, timeColumn string) error {
+	var times *Series
+
+	if method == InterpolateTime {
+		t, err := d.seriesByName(timeColumn)
+		if err != nil {
+			return err
+		}
+		times = t[0]
+	}
+
+	errs := make([]error, d.Columns())
+	parallelFor(d.Columns(), func(i int) {
+		v := (*d)[i]
+		if v.IsCategorical() == false && v != times {
+			errs[i] = v.Interpolate(method, times)
+		}
+	})
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func interpolate(method Interpolation, x0, y0, x1, y1, x float64) float64 {
+	if method == InterpolateNearest {
+		if x-x0 <= x1-x {
+			return y0
+		}
+		return y1
+	}
+
+	if x1 == x0 {
+		return y0
+	}
+
+	return y0 + (y1-y0)*(x-x0)/(x1-x0)
+}
+
+func isNA(v float64) bool {
+	return math.IsNaN(v)
+}
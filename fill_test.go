@@ -0,0 +1,111 @@
+package gander
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var na = math.NaN()
+
+func valuesMatch(e, a []float64) bool {
+	if len(e) != len(a) {
+		return false
+	}
+	for i := range e {
+		if math.IsNaN(e[i]) != math.IsNaN(a[i]) {
+			return false
+		}
+		if math.IsNaN(e[i]) == false && toleratedError(e[i], a[i]) == false {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFFill(t *testing.T) {
+	s := NewSeries("x", []float64{na, 1, na, na, 4, na})
+	s.FFill(0)
+	assert.Equal(t, true, valuesMatch([]float64{na, 1, 1, 1, 4, 4}, s.Values), "values not forward filled")
+}
+
+func TestFFillWithLimit(t *testing.T) {
+	s := NewSeries("x", []float64{1, na, na, na, 4})
+	s.FFill(2)
+	assert.Equal(t, true, valuesMatch([]float64{1, 1, 1, na, 4}, s.Values), "limit not applied")
+}
+
+func TestBFill(t *testing.T) {
+	s := NewSeries("x", []float64{na, 1, na, na, 4, na})
+	s.BFill(1)
+	assert.Equal(t, true, valuesMatch([]float64{1, 1, na, 4, 4, na}, s.Values), "values not backward filled")
+}
+
+func TestInterpolateLinear(t *testing.T) {
+	s := NewSeries("x", []float64{na, 1, na, na, 4, na})
+	err := s.Interpolate(InterpolateLinear, nil)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, valuesMatch([]float64{na, 1, 2, 3, 4, na}, s.Values), "values not interpolated")
+}
+
+func TestInterpolateNearest(t *testing.T) {
+	s := NewSeries("x", []float64{1, na, na, na, 5})
+	s.Interpolate(InterpolateNearest, nil)
+	assert.Equal(t, true, valuesMatch([]float64{1, 1, 1, 5, 5}, s.Values), "values not interpolated")
+}
+
+func TestInterpolateTime(t *testing.T) {
+	s := NewSeries("x", []float64{0, na, 10})
+	times := NewSeries("t", []float64{0, 9, 10})
+	err := s.Interpolate(InterpolateTime, times)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, valuesMatch([]float64{0, 9, 10}, s.Values), "values not weighted by time")
+}
+
+func TestInterpolateTimeWithoutTimes(t *testing.T) {
+	s := NewSeries("x", []float64{0, na, 10})
+	err := s.Interpolate(InterpolateTime, nil)
+	assert.Equal(t, "time interpolation requires a Series of times", err.Error(), "")
+}
+
+func TestInterpolateTimeWithDates(t *testing.T) {
+	s := NewSeries("x", []float64{0, na, 10})
+	times := NewCategoricalSeries("t", []string{"2020-01-01", "2020-01-10", "2020-01-11"})
+	err := s.Interpolate(InterpolateTime, times)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, valuesMatch([]float64{0, 9, 10}, s.Values), "values not weighted by time")
+}
+
+func TestInterpolateTimeInvalidTimes(t *testing.T) {
+	s := NewSeries("x", []float64{0, na, 10})
+	err := s.Interpolate(InterpolateTime, NewCategoricalSeries("t", []string{"a", "b", "c"}))
+	assert.Equal(t, "Series t holds 'a', which is not a time", err.Error(), "")
+	err = s.Interpolate(InterpolateTime, NewSeries("t", []float64{0, na, 2}))
+	assert.Equal(t, "Series of times contains missing values", err.Error(), "")
+	err = s.Interpolate(InterpolateTime, NewSeries("t", []float64{0, 2, 1}))
+	assert.Equal(t, "Series of times is not increasing", err.Error(), "")
+	assert.Equal(t, true, valuesMatch([]float64{0, na, 10}, s.Values), "values should not be changed")
+}
+
+func TestDataFrameInterpolateSkipsCategoricalData(t *testing.T) {
+	df := DataFrame{
+		NewSeries("t", []float64{0, 1, 4}),
+		NewSeries("x", []float64{0, na, 8}),
+		NewCategoricalSeries("c", []string{"a", "b", "a"}),
+	}
+	err := df.Interpolate(InterpolateTime, "t")
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []float64{0, 2, 8}, df[1].Values, "values not interpolated")
+	assert.Equal(t, []float64{0, 1, 0}, df[2].Values, "categorical data has been changed")
+}
+
+func TestDataFrameFFill(t *testing.T) {
+	df := DataFrame{
+		NewSeries("x", []float64{0, na, 8}),
+		NewSeries("y", []float64{1, na, na}),
+	}
+	df.FFill(0)
+	assert.Equal(t, []float64{0, 0, 8}, df[0].Values, "values not forward filled")
+	assert.Equal(t, []float64{1, 1, 1}, df[1].Values, "values not forward filled")
+}
@@ -0,0 +1,439 @@
+package gander
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Alignment is the alignment of the text in the columns of a table.
+type Alignment int
+
+const (
+	// AlignDefault right aligns every column when BoxDrawing is not
+	// used, as String has always shown them, and otherwise aligns the
+	// columns as AlignByKind does.
+	AlignDefault Alignment = iota
+	// AlignByKind right aligns numeric columns and left aligns
+	// categorical columns.
+	AlignByKind
+	// AlignLeft left aligns every column.
+	AlignLeft
+	// AlignRight right aligns every column.
+	AlignRight
+)
+
+// FormatOptions control how a DataFrame is displayed as text.
+type FormatOptions struct {
+	// Precision is the number of digits shown after the decimal point.
+	Precision int
+	// MaxRows is the maximum number of rows shown, or 0 to show all rows.
+	MaxRows int
+	// Elide shows rows from both the start and the end of the DataFrame,
+	// separated by a row of "...", when there are more than MaxRows rows.
+	// Otherwise only the first MaxRows rows are shown.
+	Elide bool
+	// MaxColumns is the maximum number of columns shown, or 0 to show all
+	// columns. Columns from both the start and the end are shown, separated
+	// by a column of "...".
+	MaxColumns int
+	// MinColumnWidth is the minimum width of each column, including padding.
+	MinColumnWidth int
+	// MaxColumnWidth is the maximum width of the text in each column, or 0
+	// for no limit. Longer text is truncated and ends with "...".
+	MaxColumnWidth int
+	// IntegerFormat shows columns holding only whole numbers without
+	// any digits after the decimal point.
+	IntegerFormat bool
+	// NAString is shown in place of missing (NaN) values.
+	NAString string
+	// RowNumbers shows the zero based number of each row.
+	RowNumbers bool
+	// ShowDimensions adds a line giving the number of rows and columns.
+	ShowDimensions bool
+	// BoxDrawing draws a border around each cell using Unicode
+	// box drawing characters.
+	BoxDrawing bool
+	// Align is the alignment of the columns. Row numbers are always
+	// right aligned, and a column of "..." is centred, except by
+	// AlignDefault without BoxDrawing.
+	Align Alignment
+}
+
+// DefaultFormatOptions returns the options that String uses unless
+// they are changed with SetFormatOptions.
+func DefaultFormatOptions() FormatOptions {
+	return FormatOptions{
+		Precision:      2,
+		MaxRows:        10,
+		MinColumnWidth: 12,
+		NAString:       "NaN",
+	}
+}
+
+var formatOptions = struct {
+	sync.RWMutex
+	o FormatOptions
+}{o: DefaultFormatOptions()}
+
+// SetFormatOptions sets the options used by String for every DataFrame.
+// It is safe to call while other goroutines are calling String.
+func SetFormatOptions(o FormatOptions) {
+	formatOptions.Lock()
+	defer formatOptions.Unlock()
+
+	formatOptions.o = o
+}
+
+// GetFormatOptions returns the options used by String.
+func GetFormatOptions() FormatOptions {
+	formatOptions.RLock()
+	defer formatOptions.RUnlock()
+
+	return formatOptions.o
+}
+
+// ellipsis marks a row or column that has been left out.
+const ellipsis = -1
+
+// Format returns a tabular representation of the DataFrame,
+// laid out using the provided options.
+func (d *DataFrame) Format(o FormatOptions) string {
+	t := d.formatTable(o)
+	output := ""
+
+	if o.BoxDrawing == true {
+		output = t.boxString()
+	} else {
+		output = t.plainString(o)
+	}
+
+	if o.ShowDimensions == true {
+		output += fmt.Sprintf("[%v rows x %v columns]\n", d.rowCount(), d.Columns())
+	}
+
+	return output
+}
+
+// A textTable holds the text of the header and each cell of the
+// rows and columns that are shown. The alignment of each column is
+// 'l', 'r' or 'c' for left, right or centre, which is used by the box
+// drawing and exported layouts and by the plain layout unless the
+// alignment is AlignDefault, and na marks the cells that hold
+// missing values.
+type textTable struct {
+	headers []string
+	cells   [][]string
+	align   []byte
+	na      [][]bool
+	index   bool
+}
+
+// formatTable formats the header and cell text of the rows and columns
+// to be shown. If row numbers are shown they are the first column.
+func (d *DataFrame) formatTable(o FormatOptions) textTable {
+	rows := shownIndexes(d.rowCount(), o.MaxRows, o.Elide)
+	cols := shownIndexes(d.Columns(), o.MaxColumns, true)
+
+	t := textTable{index: o.RowNumbers}
+	if o.RowNumbers == true {
+		t.headers = append(t.headers, "")
+		t.align = append(t.align, 'r')
+	}
+	for _, c := range cols {
+		switch {
+		case c == ellipsis:
+			t.headers = append(t.headers, "...")
+			t.align = append(t.align, 'c')
+		default:
+			t.headers = append(t.headers, truncate((*d)[c].Name, o.MaxColumnWidth))
+			t.align = append(t.align, o.Align.column((*d)[c].IsCategorical()))
+		}
+	}
+
+	for _, r := range rows {
+		line := []string{}
+		na := []bool{}
+		if o.RowNumbers == true {
+			if r == ellipsis {
+				line = append(line, "...")
+			} else {
+				line = append(line, strconv.Itoa(r))
+			}
+			na = append(na, false)
+		}
+		for _, c := range cols {
+			if r == ellipsis || c == ellipsis {
+				line = append(line, "...")
+				na = append(na, false)
+			} else {
+				line = append(line, truncate((*d)[c].formatValue(r, o), o.MaxColumnWidth))
+				na = append(na, isNA((*d)[c].Values[r]))
+			}
+		}
+		t.cells = append(t.cells, line)
+		t.na = append(t.na, na)
+	}
+
+	// whole number formatting is decided for each column as a whole
+	if o.IntegerFormat == true {
+		offset := len(t.headers) - len(cols)
+		for i, c := range cols {
+			if c != ellipsis && (*d)[c].isWholeNumbers() {
+				for j, r := range rows {
+					if r != ellipsis {
+						t.cells[j][i+offset] = truncate((*d)[c].formatInteger(r, o), o.MaxColumnWidth)
+					}
+				}
+			}
+		}
+	}
+
+	return t
+}
+
+// widths returns the width of the widest text in each column.
+func (t textTable) widths() []int {
+	w := make([]int, len(t.headers))
+
+	for i, h := range t.headers {
+		w[i] = utf8.RuneCountInString(h)
+		for _, r := range t.cells {
+			if l := utf8.RuneCountInString(r[i]); l > w[i] {
+				w[i] = l
+			}
+		}
+	}
+
+	return w
+}
+
+func (t textTable) plainString(o FormatOptions) string {
+	w := t.widths()
+
+	align := t.align
+	if o.Align == AlignDefault {
+		align = []byte(strings.Repeat("r", len(t.align)))
+	}
+
+	for i, h := range t.headers {
+		if t.index == true && i == 0 {
+			continue
+		}
+		// each column is padded with 1 space before and 2 after, and the
+		// header of a right aligned column may use the space before
+		hl := utf8.RuneCountInString(h)
+		if align[i] == 'r' {
+			hl--
+		}
+		if hl > w[i] {
+			w[i] = hl
+		}
+		if o.MinColumnWidth-3 > w[i] {
+			w[i] = o.MinColumnWidth - 3
+		}
+	}
+
+	output := ""
+	for i, h := range t.headers {
+		if t.index == true && i == 0 {
+			output += pad("", w[i]) + " "
+			continue
+		}
+		if align[i] == 'r' {
+			output += pad(h, w[i]+1) + "  "
+		} else {
+			output += " " + alignText(h, w[i], align[i]) + "  "
+		}
+	}
+	output += "\n"
+
+	for _, r := range t.cells {
+		for i, c := range r {
+			if t.index == true && i == 0 {
+				output += padRight(c, w[i]) + " "
+				continue
+			}
+			output += " " + alignText(c, w[i], align[i]) + "  "
+		}
+		output += "\n"
+	}
+
+	return output
+}
+
+func (t textTable) boxString() string {
+	w := t.widths()
+
+	line := func(left, mid, right string) string {
+		parts := []string{}
+		for _, x := range w {
+			parts = append(parts, strings.Repeat("─", x+2))
+		}
+		return left + strings.Join(parts, mid) + right + "\n"
+	}
+
+	row := func(r []string) string {
+		parts := []string{}
+		for i, c := range r {
+			parts = append(parts, " "+alignText(c, w[i], t.align[i])+" ")
+		}
+		return "│" + strings.Join(parts, "│") + "│\n"
+	}
+
+	output := line("┌", "┬", "┐")
+	output += row(t.headers)
+	output += line("├", "┼", "┤")
+	for _, r := range t.cells {
+		output += row(r)
+	}
+	output += line("└", "┴", "┘")
+
+	return output
+}
+
+// shownIndexes returns the indexes of the rows or columns to show out of n,
+// using ellipsis to mark where some have been left out.
+func shownIndexes(n int, max int, elide bool) []int {
+	r := []int{}
+
+	if max <= 0 || n <= max {
+		for i := 0; i < n; i++ {
+			r = append(r, i)
+		}
+		return r
+	}
+
+	if elide == false {
+		for i := 0; i < max; i++ {
+			r = append(r, i)
+		}
+		return r
+	}
+
+	head := (max + 1) / 2
+	for i := 0; i < head; i++ {
+		r = append(r, i)
+	}
+	r = append(r, ellipsis)
+	for i := n - (max - head); i < n; i++ {
+		r = append(r, i)
+	}
+
+	return r
+}
+
+// formatValue formats the value in row i of the Series for display.
+func (s *Series) formatValue(i int, o FormatOptions) string {
+	v := s.Values[i]
+
+	switch {
+	case isNA(v):
+		return o.NAString
+	case s.IsCategorical():
+		return s.categoricalLabels[v]
+	}
+
+	return strconv.FormatFloat(v, 'f', o.Precision, 64)
+}
+
+// formatInteger formats the value in row i of a Series holding only
+// whole numbers for display.
+func (s *Series) formatInteger(i int, o FormatOptions) string {
+	if isNA(s.Values[i]) {
+		return o.NAString
+	}
+
+	return strconv.FormatFloat(s.Values[i], 'f', 0, 64)
+}
+
+// isWholeNumbers returns true if the Series is numeric and every
+// value that is not missing is a whole number.
+func (s *Series) isWholeNumbers() bool {
+	if s.IsCategorical() == true {
+		return false
+	}
+
+	for _, v := range s.Values {
+		if isNA(v) == false && (v != math.Trunc(v) || math.IsInf(v, 0)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// rowCount returns the number of rows, allowing for a DataFrame
+// with no columns.
+func (d *DataFrame) rowCount() int {
+	if d.Columns() == 0 {
+		return 0
+	}
+
+	return d.Rows()
+}
+
+// truncate shortens s to at most max characters, ending with "...".
+func truncate(s string, max int) string {
+	r := []rune(s)
+
+	if max <= 0 || len(r) <= max {
+		return s
+	}
+
+	if max <= 3 {
+		return string(r[:max])
+	}
+
+	return string(r[:max-3]) + "..."
+}
+
+// pad right aligns s in a field of width characters.
+func pad(s string, width int) string {
+	n := width - utf8.RuneCountInString(s)
+	if n <= 0 {
+		return s
+	}
+
+	return strings.Repeat(" ", n) + s
+}
+
+// column returns the alignment, 'l' or 'r', of a column that is
+// categorical if categorical is true.
+func (a Alignment) column(categorical bool) byte {
+	if a == AlignLeft || (a != AlignRight && categorical == true) {
+		return 'l'
+	}
+
+	return 'r'
+}
+
+// alignText aligns s in a field of width characters, where a is
+// 'l', 'r' or 'c' for left, right or centre.
+func alignText(s string, width int, a byte) string {
+	switch a {
+	case 'l':
+		return padRight(s, width)
+	case 'c':
+		n := width - utf8.RuneCountInString(s)
+		if n <= 0 {
+			return s
+		}
+		return strings.Repeat(" ", n/2) + s + strings.Repeat(" ", n-n/2)
+	}
+
+	return pad(s, width)
+}
+
+// padRight left aligns s in a field of width characters.
+func padRight(s string, width int) string {
+	n := width - utf8.RuneCountInString(s)
+	if n <= 0 {
+		return s
+	}
+
+	return s + strings.Repeat(" ", n)
+}
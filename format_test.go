@@ -0,0 +1,160 @@
+package gander
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDefaultOptionsMatchString(t *testing.T) {
+	df, _ := NewDataFrame(createLargerSampleData())
+	assert.Equal(t, df.String(), df.Format(DefaultFormatOptions()), "default options do not match String")
+}
+
+func TestFormatElidedRowsWithRowNumbers(t *testing.T) {
+	s := `       a     b     c     d     e  
+0      1     2     3     4     5  
+1      3     5     2     2     4  
+...  ...   ...   ...   ...   ...  
+14     7     6     1     3     3  
+15     4     2     4     7     6  
+[16 rows x 5 columns]
+`
+	df, _ := NewDataFrame(createLargerSampleData())
+	o := DefaultFormatOptions()
+	o.MaxRows = 4
+	o.Elide = true
+	o.RowNumbers = true
+	o.ShowDimensions = true
+	o.IntegerFormat = true
+	o.MinColumnWidth = 0
+	assert.Equal(t, s, df.Format(o), "string not returned in correct format")
+}
+
+func TestFormatMaxColumnsAndPrecision(t *testing.T) {
+	s := `     a     ...       e  
+ 1.000     ...   5.000  
+ 3.000     ...   4.000  
+ 7.000     ...   3.000  
+ 4.000     ...   6.000  
+`
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+	o := DefaultFormatOptions()
+	o.MaxColumns = 2
+	o.Precision = 3
+	o.MinColumnWidth = 8
+	assert.Equal(t, s, df.Format(o), "string not returned in correct format")
+}
+
+func TestFormatBoxDrawingWithMissingValues(t *testing.T) {
+	s := `┌────────┬────────┐
+│ lon... │ name   │
+├────────┼────────┤
+│    1.5 │ a      │
+│     NA │ abc... │
+└────────┴────────┘
+`
+	df := DataFrame{
+		NewSeries("long name", []float64{1.5, math.NaN()}),
+		NewCategoricalSeries("name", []string{"a", "abcdefgh"}),
+	}
+	o := DefaultFormatOptions()
+	o.BoxDrawing = true
+	o.Precision = 1
+	o.NAString = "NA"
+	o.MaxColumnWidth = 6
+	assert.Equal(t, s, df.Format(o), "string not returned in correct format")
+}
+
+func TestFormatBoxDrawingAlignment(t *testing.T) {
+	s := `┌───┬─────┬─────┬───────┐
+│   │   x │ ... │ name  │
+├───┼─────┼─────┼───────┤
+│ 0 │ 1.5 │ ... │ a     │
+│ 1 │ 2.0 │ ... │ abcde │
+└───┴─────┴─────┴───────┘
+`
+	df := DataFrame{
+		NewSeries("x", []float64{1.5, 2}),
+		NewSeries("y", []float64{3, 4}),
+		NewCategoricalSeries("name", []string{"a", "abcde"}),
+	}
+	o := DefaultFormatOptions()
+	o.BoxDrawing = true
+	o.Precision = 1
+	o.MaxColumns = 2
+	o.RowNumbers = true
+	assert.Equal(t, s, df.Format(o), "string not returned in correct format")
+}
+
+func TestFormatAlignment(t *testing.T) {
+	df := DataFrame{
+		NewSeries("x", []float64{1.5, 2}),
+		NewCategoricalSeries("name", []string{"a", "abcde"}),
+	}
+	o := DefaultFormatOptions()
+	o.Precision = 1
+	o.MinColumnWidth = 0
+	o.RowNumbers = true
+
+	o.Align = AlignByKind
+	s := `     x   name   
+0  1.5   a      
+1  2.0   abcde  
+`
+	assert.Equal(t, s, df.Format(o), "columns are not aligned by kind")
+
+	o.Align = AlignLeft
+	s = `   x     name   
+0  1.5   a      
+1  2.0   abcde  
+`
+	assert.Equal(t, s, df.Format(o), "columns are not left aligned")
+
+	o.Align = AlignRight
+	o.BoxDrawing = true
+	s = `┌───┬─────┬───────┐
+│   │   x │  name │
+├───┼─────┼───────┤
+│ 0 │ 1.5 │     a │
+│ 1 │ 2.0 │ abcde │
+└───┴─────┴───────┘
+`
+	assert.Equal(t, s, df.Format(o), "columns are not right aligned")
+}
+
+func TestFormatIntegerColumnsOnly(t *testing.T) {
+	df := DataFrame{
+		NewSeries("id", []float64{1, 20000}),
+		NewSeries("x", []float64{1, 2.5}),
+	}
+	o := DefaultFormatOptions()
+	o.IntegerFormat = true
+	o.MinColumnWidth = 0
+	s := `    id      x  
+     1   1.00  
+ 20000   2.50  
+`
+	assert.Equal(t, s, df.Format(o), "string not returned in correct format")
+}
+
+func TestSetFormatOptions(t *testing.T) {
+	defer SetFormatOptions(DefaultFormatOptions())
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+	o := DefaultFormatOptions()
+	o.MaxRows = 1
+	SetFormatOptions(o)
+	assert.Equal(t, o, GetFormatOptions(), "options were not set")
+	s := `         a           b           c           d           e  
+      1.00        2.00        3.00        4.00        5.00  
+`
+	assert.Equal(t, s, df.String(), "string not returned in correct format")
+}
+
+func TestFormatEmptyDataFrame(t *testing.T) {
+	df := DataFrame{}
+	o := DefaultFormatOptions()
+	o.ShowDimensions = true
+	assert.Equal(t, "\n[0 rows x 0 columns]\n", df.Format(o), "string not returned in correct format")
+}
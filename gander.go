@@ -1,11 +1,19 @@
 package gander
 
 import (
+	"bufio"
+	"context"
 	"encoding/csv"
+	"encoding/json"
+	"fmt"
 	"io"
+	"math"
+	"mime"
+	"net/http"
 	"os"
-
-	"github.com/tophatsteve/urlreader"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // A Summary describes the statisical properties of a Series.
@@ -20,17 +28,84 @@ type Summary struct {
 	Variance float64
 }
 
+// URLOptions control how a file is loaded from a url.
+type URLOptions struct {
+	// Client is used to make the request. If it is nil,
+	// http.DefaultClient is used.
+	Client *http.Client
+	// Header holds extra headers to send with the request.
+	Header http.Header
+	// BearerToken, if set, is sent in an Authorization header.
+	BearerToken string
+	// Retries is the number of times the request is retried after a
+	// network error or a 429 or 5xx response. If a 429 or 503 response
+	// has a Retry-After header, the next retry waits for as long as it
+	// asks, which can be limited with the context.
+	Retries int
+	// Backoff is the delay before the first retry, which doubles for
+	// each following retry, up to 30s or Backoff if that is longer. If
+	// it is zero, a delay of 500ms is used.
+	Backoff time.Duration
+	// ContentTypes lists the media types that the response may have,
+	// such as "text/csv". If it is empty, any type except HTML is
+	// accepted. A response without a Content-Type header is always
+	// accepted.
+	ContentTypes []string
+	// Schema, if not nil, is used to convert the columns as
+	// NewDataFrameWithSchema does.
+	Schema Schema
+}
+
 // LoadCSVFromURL creates a DataFrame by loading a csv file
-// from a specific url. Note: at the moment this does not
-// support https.
+// from a specific http or https url.
 func LoadCSVFromURL(url string) (*DataFrame, error) {
-	u := urlreader.NewReader(url)
-	return loadCSVFromReader(u)
+	return LoadCSVFromURLContext(context.Background(), url, URLOptions{})
+}
+
+// LoadCSVFromURLContext creates a DataFrame by loading a csv file from a
+// specific http or https url, using the provided options. The request is
+// cancelled if ctx is done before the file has been loaded. Compressed
+// files are decompressed as they are by LoadCSVFromPath.
+func LoadCSVFromURLContext(ctx context.Context, url string, opts URLOptions) (*DataFrame, error) {
+	resp, err := getURL(ctx, url, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	r, err := decompress(resp.Body, resp.Request.URL.Path, "")
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close()
+
+	data, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return newDataFrame(data, opts.Schema)
 }
 
 // LoadCSVFromPath creates a DataFrame by loading a csv file
-// from a specific file system path.
+// from a specific file system path. Files compressed with gzip, bzip2
+// or Zstandard are decompressed, as are zip archives holding one file.
+// The compression is detected from the start of the file, or from
+// the file extension.
 func LoadCSVFromPath(path string) (*DataFrame, error) {
+	return LoadCSVFromZip(path, "")
+}
+
+// LoadCSVFromZip creates a DataFrame by loading the csv file with the
+// provided name from a zip archive at a specific file system path. If
+// member is empty, the archive must hold only one file.
+func LoadCSVFromZip(path string, member string) (*DataFrame, error) {
+	return LoadCSVFromZipWithSchema(path, member, nil)
+}
+
+func readCSVFromPath(path string, member string) ([][]string, error) {
 	f, err := os.Open(path)
 	if err != nil {
 		return nil, err
@@ -38,16 +113,250 @@ func LoadCSVFromPath(path string) (*DataFrame, error) {
 
 	defer f.Close()
 
-	return loadCSVFromReader(f)
+	r, err := decompress(f, path, member)
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close()
+
+	return csv.NewReader(r).ReadAll()
 }
 
-func loadCSVFromReader(reader io.Reader) (*DataFrame, error) {
-	r := csv.NewReader(reader)
-	data, err := r.ReadAll()
+// newDataFrame creates a DataFrame using NewDataFrameWithSchema
+// if a schema is provided, or NewDataFrame otherwise.
+func newDataFrame(data [][]string, schema Schema) (*DataFrame, error) {
+	if schema != nil {
+		return NewDataFrameWithSchema(data, schema)
+	}
+
+	return NewDataFrame(data)
+}
 
+// WriteCSV writes the DataFrame to w as a csv file, compressed as
+// specified. The first row holds the column names. Missing (NaN) values
+// are written as empty fields, which NewDataFrame reads back as missing
+// values, and infinite values are written as +Inf and -Inf.
+func (d *DataFrame) WriteCSV(w io.Writer, c Compression) error {
+	cw, err := compressWriter(w, c)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return NewDataFrame(data)
+	r := csv.NewWriter(cw)
+	r.Write(d.ColumnNames())
+
+	for i := 0; i < d.rowCount(); i++ {
+		row := []string{}
+		for _, s := range *d {
+			if isNA(s.Values[i]) == true {
+				row = append(row, "")
+			} else {
+				row = append(row, s.label(s.Values[i]))
+			}
+		}
+		r.Write(row)
+	}
+
+	r.Flush()
+	if err := r.Error(); err != nil {
+		cw.Close()
+		return err
+	}
+
+	return cw.Close()
+}
+
+// WriteJSON writes the DataFrame to w as a JSON array with an object
+// for each row, compressed as specified. Each object has the column
+// names as keys, in column order. Missing (NaN) values are written as
+// null.
+func (d *DataFrame) WriteJSON(w io.Writer, c Compression) error {
+	cw, err := compressWriter(w, c)
+	if err != nil {
+		return err
+	}
+
+	names := [][]byte{}
+	for _, s := range *d {
+		n, _ := json.Marshal(s.Name)
+		names = append(names, n)
+	}
+
+	b := bufio.NewWriter(cw)
+	b.WriteString("[")
+	for i := 0; i < d.rowCount(); i++ {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n{")
+		for j, s := range *d {
+			if j > 0 {
+				b.WriteString(",")
+			}
+			b.Write(names[j])
+			b.WriteString(":")
+			b.Write(s.jsonValue(i))
+		}
+		b.WriteString("}")
+	}
+	b.WriteString("\n]\n")
+
+	if err := b.Flush(); err != nil {
+		cw.Close()
+		return err
+	}
+
+	return cw.Close()
+}
+
+func (s *Series) jsonValue(i int) []byte {
+	v := s.Values[i]
+
+	switch {
+	case isNA(v) || math.IsInf(v, 0):
+		return []byte("null")
+	case s.IsCategorical():
+		b, _ := json.Marshal(s.categoricalLabels[v])
+		return b
+	}
+
+	return strconv.AppendFloat(nil, v, 'g', -1, 64)
+}
+
+// getURL requests url, retrying as set in opts, and returns the
+// response if it was successful and has an acceptable content type.
+func getURL(ctx context.Context, url string, opts URLOptions) (*http.Response, error) {
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	backoff := opts.Backoff
+	if backoff == 0 {
+		backoff = 500 * time.Millisecond
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range opts.Header {
+			req.Header[k] = v
+		}
+		if opts.BearerToken != "" {
+			req.Header.Set("Authorization", "Bearer "+opts.BearerToken)
+		}
+
+		resp, err := client.Do(req)
+		retry := false
+		delay := retryDelay(backoff, attempt)
+		switch {
+		case err != nil:
+			retry = ctx.Err() == nil
+		case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+			resp.Body.Close()
+			err = fmt.Errorf("request for %s failed with status %s", url, resp.Status)
+			retry = true
+			if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+				if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+					delay = d
+				}
+			}
+		case resp.StatusCode >= 300:
+			resp.Body.Close()
+			return nil, fmt.Errorf("request for %s failed with status %s", url, resp.Status)
+		default:
+			if err := checkContentType(resp.Header.Get("Content-Type"), opts.ContentTypes); err != nil {
+				resp.Body.Close()
+				return nil, err
+			}
+			return resp, nil
+		}
+
+		if retry == false || attempt >= opts.Retries {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// maxBackoff is the longest delay between retries,
+// unless a longer backoff is requested.
+const maxBackoff = 30 * time.Second
+
+// retryDelay returns the delay before the retry following the
+// zero based attempt, doubling backoff for each attempt.
+func retryDelay(backoff time.Duration, attempt int) time.Duration {
+	limit := maxBackoff
+	if backoff > limit {
+		limit = backoff
+	}
+
+	d := backoff
+	for i := 0; i < attempt && d < limit; i++ {
+		d *= 2
+	}
+
+	if d > limit {
+		d = limit
+	}
+
+	return d
+}
+
+// retryAfter returns the delay asked for by the value of a Retry-After
+// header, which is either a number of seconds or an HTTP date. It returns
+// false if the value is empty or cannot be parsed.
+func retryAfter(h string) (time.Duration, bool) {
+	if s, err := strconv.ParseInt(h, 10, 64); err == nil && s >= 0 {
+		if s > int64(math.MaxInt64/time.Second) {
+			s = int64(math.MaxInt64 / time.Second)
+		}
+		return time.Duration(s) * time.Second, true
+	}
+
+	t, err := http.ParseTime(h)
+	if err != nil {
+		return 0, false
+	}
+
+	if d := time.Until(t); d > 0 {
+		return d, true
+	}
+
+	return 0, true
+}
+
+func checkContentType(header string, allowed []string) error {
+	if header == "" {
+		return nil
+	}
+
+	t, _, err := mime.ParseMediaType(header)
+	if err != nil {
+		return err
+	}
+
+	if len(allowed) == 0 {
+		if t == "text/html" || t == "application/xhtml+xml" {
+			return fmt.Errorf("unexpected content type '%s'", t)
+		}
+		return nil
+	}
+
+	for _, a := range allowed {
+		if strings.EqualFold(t, a) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unexpected content type '%s'", t)
 }
@@ -1,6 +1,7 @@
 package gander
 
 import (
+	"context"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"net/http"
@@ -8,6 +9,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 )
 
 func createSampleDataWithHeaders() [][]string {
@@ -90,6 +92,141 @@ func TestLoadCSVFromInvalidUrl(t *testing.T) {
 	assert.Equal(t, true, strings.Contains(err.Error(), "no such host"), "error is not 'no such host'")
 }
 
+func TestLoadCSVFromURLContextHeaders(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		fmt.Fprintln(w, "auth,key")
+		fmt.Fprintf(w, "%s,%s\n", r.Header.Get("Authorization"), r.Header.Get("X-Key"))
+		fmt.Fprintln(w, "none,none")
+	}))
+	defer ts.Close()
+
+	opts := URLOptions{
+		Client:       ts.Client(),
+		Header:       http.Header{"X-Key": []string{"k1"}},
+		BearerToken:  "t0k",
+		ContentTypes: []string{"text/csv"},
+	}
+	df, err := LoadCSVFromURLContext(context.Background(), ts.URL, opts)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, "Bearer t0k", (*df)[0].label((*df)[0].Values[0]), "authorization header is not correct")
+	assert.Equal(t, "k1", (*df)[1].label((*df)[1].Values[0]), "custom header is not correct")
+}
+
+func TestLoadCSVFromURLContextRetry(t *testing.T) {
+	n := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n++
+		if n < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "a,b")
+		fmt.Fprintln(w, "1,2")
+	}))
+	defer ts.Close()
+
+	df, err := LoadCSVFromURLContext(context.Background(), ts.URL, URLOptions{Retries: 2, Backoff: time.Millisecond})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 3, n, "request count is not correct")
+	assert.Equal(t, 1, df.Rows(), "dataframe does not have the correct number of rows")
+
+	n = 0
+	_, err = LoadCSVFromURLContext(context.Background(), ts.URL, URLOptions{Retries: 1, Backoff: time.Millisecond})
+	assert.Equal(t, 2, n, "request count is not correct")
+	assert.Equal(t, fmt.Sprintf("request for %s failed with status 503 Service Unavailable", ts.URL), err.Error(), "error message is not correct")
+}
+
+func TestLoadCSVFromURLContextRetryAfter(t *testing.T) {
+	n := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n++
+		if n == 1 {
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, "slow down", http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprintln(w, "a,b\n1,2")
+	}))
+	defer ts.Close()
+
+	// the backoff would take longer than the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	df, err := LoadCSVFromURLContext(ctx, ts.URL, URLOptions{Retries: 1, Backoff: time.Hour})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 2, n, "request count is not correct")
+	assert.Equal(t, 1, df.Rows(), "dataframe does not have the correct number of rows")
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, time.Second, retryDelay(time.Second, 0), "first delay is not correct")
+	assert.Equal(t, 8*time.Second, retryDelay(time.Second, 3), "delay should double")
+	assert.Equal(t, maxBackoff, retryDelay(time.Second, 100), "delay should be capped")
+	assert.Equal(t, maxBackoff, retryDelay(time.Millisecond, 1000), "delay should be capped")
+	assert.Equal(t, time.Minute, retryDelay(time.Minute, 5), "a longer backoff should not be shortened")
+}
+
+func TestRetryAfter(t *testing.T) {
+	d, ok := retryAfter("120")
+	assert.Equal(t, true, ok, "seconds should be parsed")
+	assert.Equal(t, 2*time.Minute, d, "delay is not correct")
+
+	d, ok = retryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	assert.Equal(t, true, ok, "date should be parsed")
+	assert.InDelta(t, float64(time.Hour), float64(d), float64(2*time.Second), "delay is not correct")
+
+	d, ok = retryAfter("Wed, 21 Oct 2015 07:28:00 GMT")
+	assert.Equal(t, true, ok, "date should be parsed")
+	assert.Equal(t, time.Duration(0), d, "a date in the past should not be waited for")
+
+	_, ok = retryAfter("")
+	assert.Equal(t, false, ok, "empty value should not be parsed")
+	_, ok = retryAfter("soon")
+	assert.Equal(t, false, ok, "invalid value should not be parsed")
+}
+
+func TestLoadCSVFromURLContextNotFound(t *testing.T) {
+	n := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n++
+		http.NotFound(w, r)
+	}))
+	defer ts.Close()
+
+	_, err := LoadCSVFromURLContext(context.Background(), ts.URL, URLOptions{Retries: 3, Backoff: time.Millisecond})
+	assert.Equal(t, 1, n, "client errors should not be retried")
+	assert.Equal(t, fmt.Sprintf("request for %s failed with status 404 Not Found", ts.URL), err.Error(), "error message is not correct")
+}
+
+func TestLoadCSVFromURLContextContentType(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprintln(w, "<html></html>")
+	}))
+	defer ts.Close()
+
+	_, err := LoadCSVFromURLContext(context.Background(), ts.URL, URLOptions{})
+	assert.Equal(t, "unexpected content type 'text/html'", err.Error(), "error message is not correct")
+
+	_, err = LoadCSVFromURLContext(context.Background(), ts.URL, URLOptions{ContentTypes: []string{"text/csv"}})
+	assert.Equal(t, "unexpected content type 'text/html'", err.Error(), "error message is not correct")
+}
+
+func TestLoadCSVFromURLContextCancel(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := LoadCSVFromURLContext(ctx, ts.URL, URLOptions{Retries: 5, Backoff: time.Second})
+	assert.Equal(t, context.DeadlineExceeded, err, "error is not deadline exceeded")
+}
+
 func TestLoadCSVFromPath(t *testing.T) {
 	df, err := LoadCSVFromPath("./testdata/MOCK_DATA.csv")
 	assert.Equal(t, nil, err, "error is not nil")
@@ -0,0 +1,214 @@
+package gander
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// A BinRule is a rule for choosing the number of bins in a histogram
+// from the data.
+type BinRule int
+
+const (
+	// BinsSturges uses log2(n) + 1 bins, which suits roughly normal data.
+	BinsSturges BinRule = iota
+	// BinsScott uses bins of width 3.49 * standard deviation / n^(1/3).
+	BinsScott
+	// BinsFreedmanDiaconis uses bins of width 2 * interquartile range / n^(1/3),
+	// which is less affected by outliers.
+	BinsFreedmanDiaconis
+)
+
+// A Histogram holds the number of values falling into each bin. There
+// is one more edge than there are counts. Bins include their right edge,
+// and the first bin also includes its left edge, in the same way as Hist.
+type Histogram struct {
+	Edges  []float64
+	Counts []int
+}
+
+// A Density holds a kernel density estimate, giving the estimated
+// density at each point in X.
+type Density struct {
+	X         []float64
+	Density   []float64
+	Bandwidth float64
+}
+
+// Histogram counts the values in the Series in the provided number
+// of bins of equal width, covering the range of the finite values.
+// Missing (NaN) and infinite values are not counted.
+func (s *Series) Histogram(bins int) (*Histogram, error) {
+	if bins < 1 {
+		return nil, errors.New("number of bins must be at least 1")
+	}
+
+	if len(s.finite()) == 0 {
+		return nil, fmt.Errorf("Series %s has no values", s.Name)
+	}
+
+	return s.HistogramEdges(s.equalWidthEdges(bins))
+}
+
+// HistogramEdges counts the values in the Series in the bins defined
+// by edges, which must be increasing. Values outside the edges and
+// missing (NaN) values are not counted.
+func (s *Series) HistogramEdges(edges []float64) (*Histogram, error) {
+	if s.IsCategorical() == true {
+		return nil, fmt.Errorf("Series %s is categorical", s.Name)
+	}
+
+	if len(edges) < 2 {
+		return nil, errors.New("at least 2 bin edges are required")
+	}
+
+	for i := 1; i < len(edges); i++ {
+		if edges[i] <= edges[i-1] {
+			return nil, errors.New("bin edges must be increasing and unique")
+		}
+	}
+
+	h := Histogram{Edges: edges, Counts: make([]int, len(edges)-1)}
+	for _, v := range s.Values {
+		if b := findBin(edges, v, true, true); b >= 0 {
+			h.Counts[b]++
+		}
+	}
+
+	return &h, nil
+}
+
+// HistogramAuto counts the values in the Series in bins of equal width,
+// choosing the number of bins using the provided rule. Missing (NaN)
+// and infinite values are not counted.
+func (s *Series) HistogramAuto(rule BinRule) (*Histogram, error) {
+	if s.IsCategorical() == true {
+		return nil, fmt.Errorf("Series %s is categorical", s.Name)
+	}
+
+	v := s.finite()
+	if len(v) == 0 {
+		return nil, fmt.Errorf("Series %s has no values", s.Name)
+	}
+
+	return s.Histogram(binCount(v, rule))
+}
+
+// KDE returns a Gaussian kernel density estimate of the distribution of
+// the values in the Series, evaluated at the provided number of evenly
+// spaced points covering the values. If bandwidth is not greater than
+// zero, it is chosen using Silverman's rule of thumb.
+func (s *Series) KDE(bandwidth float64, points int) (*Density, error) {
+	if s.IsCategorical() == true {
+		return nil, fmt.Errorf("Series %s is categorical", s.Name)
+	}
+
+	if points < 2 {
+		return nil, errors.New("at least 2 points are required")
+	}
+
+	v := s.valid()
+	if len(v) < 2 {
+		return nil, fmt.Errorf("Series %s has fewer than 2 values", s.Name)
+	}
+
+	if bandwidth <= 0 {
+		bandwidth = silvermanBandwidth(v)
+	}
+
+	sort.Float64s(v)
+	lo := v[0] - 3*bandwidth
+	hi := v[len(v)-1] + 3*bandwidth
+
+	d := Density{Bandwidth: bandwidth}
+	norm := 1 / (float64(len(v)) * bandwidth * math.Sqrt(2*math.Pi))
+
+	for i := 0; i < points; i++ {
+		x := lo + (hi-lo)*float64(i)/float64(points-1)
+		t := 0.0
+		for _, xi := range v {
+			u := (x - xi) / bandwidth
+			t += math.Exp(-0.5 * u * u)
+		}
+		d.X = append(d.X, x)
+		d.Density = append(d.Density, t*norm)
+	}
+
+	return &d, nil
+}
+
+// valid returns the values of the Series that are not missing.
+func (s *Series) valid() []float64 {
+	r := []float64{}
+
+	for _, v := range s.Values {
+		if isNA(v) == false {
+			r = append(r, v)
+		}
+	}
+
+	return r
+}
+
+// finite returns the values of the Series that are neither missing
+// (NaN) nor infinite.
+func (s *Series) finite() []float64 {
+	r := []float64{}
+
+	for _, v := range s.Values {
+		if isFinite(v) == true {
+			r = append(r, v)
+		}
+	}
+
+	return r
+}
+
+func binCount(v []float64, rule BinRule) int {
+	n := float64(len(v))
+	sturges := int(math.Ceil(math.Log2(n))) + 1
+
+	sorted := append([]float64{}, v...)
+	sort.Float64s(sorted)
+	spread := sorted[len(sorted)-1] - sorted[0]
+
+	var width float64
+	switch rule {
+	case BinsScott:
+		if len(v) > 1 {
+			width = 3.49 * math.Sqrt(sampleVariance(v)) / math.Cbrt(n)
+		}
+	case BinsFreedmanDiaconis:
+		iqr := quantile(sorted, 0.75) - quantile(sorted, 0.25)
+		width = 2 * iqr / math.Cbrt(n)
+	default:
+		return sturges
+	}
+
+	if width <= 0 || spread == 0 {
+		return sturges
+	}
+
+	return int(math.Ceil(spread / width))
+}
+
+func silvermanBandwidth(v []float64) float64 {
+	sorted := append([]float64{}, v...)
+	sort.Float64s(sorted)
+
+	sigma := math.Sqrt(sampleVariance(v))
+	iqr := (quantile(sorted, 0.75) - quantile(sorted, 0.25)) / 1.34
+
+	a := sigma
+	if iqr > 0 && iqr < sigma {
+		a = iqr
+	}
+
+	if a == 0 {
+		a = 1
+	}
+
+	return 0.9 * a * math.Pow(float64(len(v)), -0.2)
+}
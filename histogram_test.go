@@ -0,0 +1,82 @@
+package gander
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogramEqualWidth(t *testing.T) {
+	s := createTestSeries() // 0, 1, 1, 2, 3, 3, 4, 4, 7, 7
+	h, err := s.Histogram(2)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []float64{0, 3.5, 7}, h.Edges, "edges are not correct")
+	assert.Equal(t, []int{6, 4}, h.Counts, "counts are not correct")
+}
+
+func TestHistogramMatchesHist(t *testing.T) {
+	s := createTestSeries()
+	h, _ := s.Histogram(3)
+	c, _ := s.Hist(3)
+	for i, l := range intervalLabels(h.Edges, true, true) {
+		assert.Equal(t, h.Counts[i], c[l], "Hist and Histogram do not agree")
+	}
+}
+
+func TestHistogramEdgesIgnoresMissingAndOutOfRange(t *testing.T) {
+	s := NewSeries("x", []float64{math.NaN(), -1, 0, 5, 10, 11})
+	h, err := s.HistogramEdges([]float64{0, 5, 10})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []int{2, 1}, h.Counts, "counts are not correct")
+}
+
+func TestHistogramCategoricalData(t *testing.T) {
+	s := createTestCategoricalSeries()
+	_, err := s.HistogramAuto(BinsSturges)
+	assert.Equal(t, "Series MySeries is categorical", err.Error(), "")
+}
+
+func TestHistogramAutoBinRules(t *testing.T) {
+	df, _ := LoadCSVFromPath("./testdata/MOCK_DATA.csv")
+	salary := (*df)[3]
+
+	for _, c := range []struct {
+		rule BinRule
+		bins int
+	}{{BinsSturges, 11}, {BinsScott, 10}, {BinsFreedmanDiaconis, 10}} {
+		h, err := salary.HistogramAuto(c.rule)
+		assert.Equal(t, nil, err, "error is not nil")
+		assert.Equal(t, c.bins, len(h.Counts), "wrong number of bins")
+		total := 0
+		for _, v := range h.Counts {
+			total += v
+		}
+		assert.Equal(t, 1000, total, "not all values were counted")
+	}
+
+	assert.Equal(t, 5, binCount(createTestSeries().Values, BinsSturges), "sturges bin count is not correct")
+	assert.Equal(t, 2, binCount(createTestSeries().Values, BinsScott), "scott bin count is not correct")
+	assert.Equal(t, 3, binCount(createTestSeries().Values, BinsFreedmanDiaconis), "freedman-diaconis bin count is not correct")
+}
+
+func TestKDE(t *testing.T) {
+	s := createTestSeries()
+	d, err := s.KDE(0, 512)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 512, len(d.X), "wrong number of points")
+	assert.InDelta(t, 0.9*(2.75/1.34)*math.Pow(10, -0.2), d.Bandwidth, 1e-10, "bandwidth is not correct")
+
+	area := 0.0
+	for i := 1; i < len(d.X); i++ {
+		area += (d.X[i] - d.X[i-1]) * (d.Density[i] + d.Density[i-1]) / 2
+	}
+	assert.InDelta(t, 1, area, 0.01, "density does not integrate to one")
+}
+
+func TestKDEFixedBandwidth(t *testing.T) {
+	s := NewSeries("x", []float64{0, 0})
+	d, _ := s.KDE(1, 3)
+	assert.Equal(t, []float64{-3, 0, 3}, d.X, "points are not correct")
+	assert.InDelta(t, 1/math.Sqrt(2*math.Pi), d.Density[1], 1e-12, "density is not correct")
+}
@@ -0,0 +1,315 @@
+package gander
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// A TestResult holds the outcome of a statistical hypothesis test.
+// DF holds the degrees of freedom of the test statistic's distribution,
+// where it has one. For ANOVA, DF holds the degrees of freedom between
+// groups and DF2 holds the degrees of freedom within groups.
+type TestResult struct {
+	Test      string
+	Statistic float64
+	PValue    float64
+	DF        float64
+	DF2       float64
+}
+
+// String returns a readable description of the test result.
+func (r *TestResult) String() string {
+	df := ""
+	switch {
+	case r.DF2 != 0:
+		df = fmt.Sprintf(", df = (%.4g, %.4g)", r.DF, r.DF2)
+	case r.DF != 0:
+		df = fmt.Sprintf(", df = %.4g", r.DF)
+	}
+
+	return fmt.Sprintf("%s: statistic = %.4f%s, p-value = %.4g", r.Test, r.Statistic, df, r.PValue)
+}
+
+// TTest carries out a two-sample t-test of whether the mean of the
+// column value differs between the two categories of the categorical
+// column group. If equalVariance is true, Student's t-test is used,
+// otherwise Welch's t-test is used. The statistic is positive when the
+// mean of the first category is larger.
+func (d *DataFrame) TTest(value, group string, equalVariance bool) (*TestResult, error) {
+	g, err := d.groupValues(value, group)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(g) != 2 {
+		return nil, fmt.Errorf("Series %s must have exactly 2 categories", group)
+	}
+
+	a, b := g[0], g[1]
+	if len(a) < 2 || len(b) < 2 {
+		return nil, errors.New("each category must have at least 2 values")
+	}
+
+	na, nb := float64(len(a)), float64(len(b))
+	va, vb := sampleVariance(a), sampleVariance(b)
+	diff := sum(a)/na - sum(b)/nb
+
+	r := TestResult{}
+	if equalVariance == true {
+		r.Test = "Two-sample t-test"
+		r.DF = na + nb - 2
+		pooled := ((na-1)*va + (nb-1)*vb) / r.DF
+		r.Statistic = diff / math.Sqrt(pooled*(1/na+1/nb))
+	} else {
+		r.Test = "Welch two-sample t-test"
+		sa, sb := va/na, vb/nb
+		r.DF = (sa + sb) * (sa + sb) / (sa*sa/(na-1) + sb*sb/(nb-1))
+		r.Statistic = diff / math.Sqrt(sa+sb)
+	}
+	r.PValue = studentTPValue(r.Statistic, r.DF)
+
+	return &r, nil
+}
+
+// PairedTTest carries out a paired t-test of whether the mean difference
+// between the columns a and b, taken row by row, is zero.
+func (d *DataFrame) PairedTTest(a, b string) (*TestResult, error) {
+	s, err := d.seriesByName(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range s {
+		if v.IsCategorical() == true {
+			return nil, fmt.Errorf("Series %s is categorical", v.Name)
+		}
+	}
+
+	diff := []float64{}
+	for i := range s[0].Values {
+		x := s[0].Values[i] - s[1].Values[i]
+		if isNA(x) == false {
+			diff = append(diff, x)
+		}
+	}
+
+	if len(diff) < 2 {
+		return nil, errors.New("at least 2 pairs of values are required")
+	}
+
+	n := float64(len(diff))
+	r := TestResult{Test: "Paired t-test", DF: n - 1}
+	r.Statistic = (sum(diff) / n) / math.Sqrt(sampleVariance(diff)/n)
+	r.PValue = studentTPValue(r.Statistic, r.DF)
+
+	return &r, nil
+}
+
+// ANOVA carries out a one-way analysis of variance of whether the mean
+// of the column value differs between the categories of the categorical
+// column group.
+func (d *DataFrame) ANOVA(value, group string) (*TestResult, error) {
+	g, err := d.groupValues(value, group)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(g) < 2 {
+		return nil, fmt.Errorf("Series %s must have at least 2 categories", group)
+	}
+
+	all := []float64{}
+	for _, v := range g {
+		all = append(all, v...)
+	}
+	mu := sum(all) / float64(len(all))
+
+	between, within := 0.0, 0.0
+	for _, v := range g {
+		m := sum(v) / float64(len(v))
+		between += float64(len(v)) * (m - mu) * (m - mu)
+		for _, x := range v {
+			within += (x - m) * (x - m)
+		}
+	}
+
+	r := TestResult{Test: "One-way ANOVA"}
+	r.DF = float64(len(g) - 1)
+	r.DF2 = float64(len(all) - len(g))
+	if r.DF2 < 1 {
+		return nil, errors.New("there are not enough values for each category")
+	}
+	r.Statistic = (between / r.DF) / (within / r.DF2)
+	r.PValue = fPValue(r.Statistic, r.DF, r.DF2)
+
+	return &r, nil
+}
+
+// MannWhitneyU carries out a Mann-Whitney U test of whether the values of
+// the column value tend to be larger in one of the two categories of the
+// categorical column group. The statistic is U for the first category, and
+// the p-value uses the normal approximation, with corrections for ties and
+// continuity.
+func (d *DataFrame) MannWhitneyU(value, group string) (*TestResult, error) {
+	g, err := d.groupValues(value, group)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(g) != 2 {
+		return nil, fmt.Errorf("Series %s must have exactly 2 categories", group)
+	}
+
+	n1, n2 := float64(len(g[0])), float64(len(g[1]))
+	if n1 == 0 || n2 == 0 {
+		return nil, errors.New("each category must have at least 1 value")
+	}
+
+	ranks, ties := rank(append(append([]float64{}, g[0]...), g[1]...))
+	r1 := sum(ranks[:len(g[0])])
+	n := n1 + n2
+
+	r := TestResult{Test: "Mann-Whitney U test"}
+	r.Statistic = r1 - n1*(n1+1)/2
+
+	mu := n1 * n2 / 2
+	sigma := math.Sqrt(n1 * n2 / 12 * ((n + 1) - ties/(n*(n-1))))
+	z := (math.Abs(r.Statistic-mu) - 0.5) / sigma
+	r.PValue = math.Min(1, normalPValue(math.Max(z, 0)))
+
+	return &r, nil
+}
+
+// ChiSquare carries out a chi-square test of independence between
+// the two categorical columns a and b. Rows where either column is
+// missing are ignored.
+func (d *DataFrame) ChiSquare(a, b string) (*TestResult, error) {
+	s, err := d.seriesByName(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range s {
+		if v.IsCategorical() == false {
+			return nil, fmt.Errorf("Series %s is not categorical", v.Name)
+		}
+	}
+
+	observed := map[[2]string]int{}
+	rows := map[string]int{}
+	cols := map[string]int{}
+	n := 0
+	for i := range s[0].Values {
+		if isNA(s[0].Values[i]) || isNA(s[1].Values[i]) {
+			continue
+		}
+		rl, cl := s[0].label(s[0].Values[i]), s[1].label(s[1].Values[i])
+		observed[[2]string{rl, cl}]++
+		rows[rl]++
+		cols[cl]++
+		n++
+	}
+
+	if len(rows) < 2 || len(cols) < 2 {
+		return nil, errors.New("each column must have at least 2 categories")
+	}
+
+	r := TestResult{Test: "Chi-square test of independence"}
+	for rl, rc := range rows {
+		for cl, cc := range cols {
+			e := float64(rc) * float64(cc) / float64(n)
+			o := float64(observed[[2]string{rl, cl}])
+			r.Statistic += (o - e) * (o - e) / e
+		}
+	}
+	r.DF = float64((len(rows) - 1) * (len(cols) - 1))
+	r.PValue = chiSquarePValue(r.Statistic, r.DF)
+
+	return &r, nil
+}
+
+// groupValues splits the values of the column value by the categories
+// of the categorical column group, ignoring missing values. The groups
+// are in the same order as the categories, and empty groups are omitted.
+func (d *DataFrame) groupValues(value, group string) ([][]float64, error) {
+	s, err := d.seriesByName(value, group)
+	if err != nil {
+		return nil, err
+	}
+
+	if s[0].IsCategorical() == true {
+		return nil, fmt.Errorf("Series %s is categorical", value)
+	}
+
+	if s[1].IsCategorical() == false {
+		return nil, fmt.Errorf("Series %s is not categorical", group)
+	}
+
+	l := s[1].levels()
+	idx := map[string]int{}
+	for i, v := range l {
+		idx[v] = i
+	}
+
+	g := make([][]float64, len(l))
+	for i, v := range s[0].Values {
+		c := s[1].Values[i]
+		if isNA(v) || isNA(c) {
+			continue
+		}
+		j := idx[s[1].label(c)]
+		g[j] = append(g[j], v)
+	}
+
+	r := [][]float64{}
+	for _, v := range g {
+		if len(v) > 0 {
+			r = append(r, v)
+		}
+	}
+
+	return r, nil
+}
+
+// rank returns the rank of each value, starting from 1, giving tied
+// values the average of their ranks. It also returns the sum of t^3 - t
+// over each group of t tied values, which is used to correct for ties.
+func rank(v []float64) ([]float64, float64) {
+	idx := make([]int, len(v))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return v[idx[i]] < v[idx[j]]
+	})
+
+	r := make([]float64, len(v))
+	ties := 0.0
+	for i := 0; i < len(idx); {
+		j := i
+		for j+1 < len(idx) && v[idx[j+1]] == v[idx[i]] {
+			j++
+		}
+		for k := i; k <= j; k++ {
+			r[idx[k]] = float64(i+j)/2 + 1
+		}
+		t := float64(j - i + 1)
+		ties += t*t*t - t
+		i = j + 1
+	}
+
+	return r, ties
+}
+
+func sampleVariance(r []float64) float64 {
+	mu := sum(r) / float64(len(r))
+	t := 0.0
+
+	for _, v := range r {
+		t += (v - mu) * (v - mu)
+	}
+
+	return t / float64(len(r)-1)
+}
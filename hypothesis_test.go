@@ -0,0 +1,151 @@
+package gander
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createGroupedTestData() *DataFrame {
+	return &DataFrame{
+		NewSeries("value", []float64{
+			5.1, 4.9, 6.2, 5.8, 6.0, 5.5, 5.3,
+			6.5, 6.1, 7.0, 6.8, 7.2, 6.4,
+			7.0, 7.5, 8.1, 6.9,
+		}),
+		NewCategoricalSeries("group", []string{
+			"a", "a", "a", "a", "a", "a", "a",
+			"b", "b", "b", "b", "b", "b",
+			"c", "c", "c", "c",
+		}),
+	}
+}
+
+func TestTTestEqualVariance(t *testing.T) {
+	df := createGroupedTestData()
+	df.DropRows(13, 14, 15, 16)
+	r, err := df.TTest("value", "group", true)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.InDelta(t, -4.506082, r.Statistic, 1e-6, "statistic is not correct")
+	assert.Equal(t, 11.0, r.DF, "degrees of freedom are not correct")
+	assert.InDelta(t, 0.000892232, r.PValue, 1e-9, "p-value is not correct")
+}
+
+func TestTTestWelch(t *testing.T) {
+	df := createGroupedTestData()
+	df.DropRows(13, 14, 15, 16)
+	r, err := df.TTest("value", "group", false)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.InDelta(t, -4.566412, r.Statistic, 1e-6, "statistic is not correct")
+	assert.InDelta(t, 10.999260, r.DF, 1e-6, "degrees of freedom are not correct")
+	assert.InDelta(t, 0.000808251, r.PValue, 1e-9, "p-value is not correct")
+}
+
+func TestTTestNonContiguousCategories(t *testing.T) {
+	df := &DataFrame{
+		NewSeries("value", []float64{
+			5.1, 4.9, 6.2, 5.8, 6.0, 5.5, 5.3,
+			0, 0,
+			6.5, 6.1, 7.0, 6.8, 7.2, 6.4,
+		}),
+		NewCategoricalSeries("group", []string{
+			"a", "a", "a", "a", "a", "a", "a",
+			"x", "x",
+			"b", "b", "b", "b", "b", "b",
+		}),
+	}
+	// dropping the rows of x leaves a gap between the values of a and b
+	df.DropRows(7, 8)
+	r, err := df.TTest("value", "group", true)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.InDelta(t, -4.506082, r.Statistic, 1e-6, "statistic is not correct")
+}
+
+func TestTTestTooManyCategories(t *testing.T) {
+	_, err := createGroupedTestData().TTest("value", "group", true)
+	assert.Equal(t, "Series group must have exactly 2 categories", err.Error(), "")
+}
+
+func TestPairedTTest(t *testing.T) {
+	df := DataFrame{
+		NewSeries("before", []float64{10, 12, 9, 14, 11}),
+		NewSeries("after", []float64{12, 13, 11, 15, 13}),
+	}
+	r, err := df.PairedTTest("before", "after")
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.InDelta(t, -6.531973, r.Statistic, 1e-6, "statistic is not correct")
+	assert.InDelta(t, 0.002837846, r.PValue, 1e-9, "p-value is not correct")
+}
+
+func TestANOVA(t *testing.T) {
+	r, err := createGroupedTestData().ANOVA("value", "group")
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.InDelta(t, 21.007609, r.Statistic, 1e-6, "statistic is not correct")
+	assert.Equal(t, 2.0, r.DF, "degrees of freedom are not correct")
+	assert.Equal(t, 14.0, r.DF2, "degrees of freedom are not correct")
+	assert.InDelta(t, 6.091917e-05, r.PValue, 1e-10, "p-value is not correct")
+}
+
+func TestMannWhitneyU(t *testing.T) {
+	df := createGroupedTestData()
+	df.DropRows(14, 15, 16)
+	(*df)[0].Values[13] = 6.2
+	(*df)[1].Values[13] = (*df)[1].Values[7]
+	r, err := df.MannWhitneyU("value", "group")
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 1.5, r.Statistic, "statistic is not correct")
+	assert.InDelta(t, 0.004000668, r.PValue, 1e-9, "p-value is not correct")
+}
+
+func TestChiSquare(t *testing.T) {
+	sex := []string{}
+	answer := []string{}
+	for _, c := range []struct {
+		s, a string
+		n    int
+	}{{"M", "y", 20}, {"M", "n", 15}, {"F", "y", 30}, {"F", "n", 10}} {
+		for i := 0; i < c.n; i++ {
+			sex = append(sex, c.s)
+			answer = append(answer, c.a)
+		}
+	}
+	df := DataFrame{NewCategoricalSeries("sex", sex), NewCategoricalSeries("answer", answer)}
+	r, err := df.ChiSquare("sex", "answer")
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.InDelta(t, 2.678571, r.Statistic, 1e-6, "statistic is not correct")
+	assert.Equal(t, 1.0, r.DF, "degrees of freedom are not correct")
+	assert.InDelta(t, 0.101706930, r.PValue, 1e-9, "p-value is not correct")
+}
+
+func TestChiSquareMissingValues(t *testing.T) {
+	data := [][]string{{"sex", "answer"}}
+	for _, c := range []struct {
+		s, a string
+		n    int
+	}{{"M", "y", 20}, {"M", "n", 15}, {"F", "y", 30}, {"F", "n", 10}, {"F", "", 25}, {"M", "", 5}} {
+		for i := 0; i < c.n; i++ {
+			data = append(data, []string{c.s, c.a})
+		}
+	}
+	// empty answers are missing values
+	df, _ := NewDataFrame(data)
+	r, err := df.ChiSquare("sex", "answer")
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.InDelta(t, 2.678571, r.Statistic, 1e-6, "rows with missing values were not ignored")
+	assert.Equal(t, 1.0, r.DF, "degrees of freedom are not correct")
+}
+
+func TestChiSquareNumericData(t *testing.T) {
+	_, err := createGroupedTestData().ChiSquare("group", "value")
+	assert.Equal(t, "Series value is not categorical", err.Error(), "")
+}
+
+func TestChiSquarePValue(t *testing.T) {
+	assert.InDelta(t, 0.050010, chiSquarePValue(11.07, 5), 1e-6, "p-value is not correct")
+}
+
+func TestTestResultString(t *testing.T) {
+	r := TestResult{Test: "One-way ANOVA", Statistic: 21.007609, PValue: 9.593186e-05, DF: 2, DF2: 14}
+	assert.Equal(t, "One-way ANOVA: statistic = 21.0076, df = (2, 14), p-value = 9.593e-05", fmt.Sprint(&r), "string is not correct")
+}
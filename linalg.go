@@ -0,0 +1,60 @@
+package gander
+
+import (
+	"errors"
+	"math"
+)
+
+// invert returns the inverse of the square matrix a, using Gauss-Jordan
+// elimination with partial pivoting. The matrix a is not changed.
+func invert(a [][]float64) ([][]float64, error) {
+	n := len(a)
+	m := make([][]float64, n)
+	scale := 0.0
+
+	for i := range a {
+		for _, v := range a[i] {
+			scale = math.Max(scale, math.Abs(v))
+		}
+
+		m[i] = make([]float64, 2*n)
+		copy(m[i], a[i])
+		m[i][n+i] = 1
+	}
+
+	for c := 0; c < n; c++ {
+		p := c
+		for r := c + 1; r < n; r++ {
+			if math.Abs(m[r][c]) > math.Abs(m[p][c]) {
+				p = r
+			}
+		}
+
+		if math.Abs(m[p][c]) <= scale*1e-12 {
+			return nil, errors.New("matrix is singular")
+		}
+
+		m[c], m[p] = m[p], m[c]
+
+		pivot := m[c][c]
+		for j := range m[c] {
+			m[c][j] /= pivot
+		}
+
+		for r := 0; r < n; r++ {
+			if r != c && m[r][c] != 0 {
+				f := m[r][c]
+				for j := range m[r] {
+					m[r][j] -= f * m[c][j]
+				}
+			}
+		}
+	}
+
+	r := make([][]float64, n)
+	for i := range m {
+		r[i] = m[i][n:]
+	}
+
+	return r, nil
+}
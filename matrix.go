@@ -0,0 +1,138 @@
+package gander
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// ToMatrix returns the values of the named columns as a gonum matrix,
+// with a row for each row of the DataFrame and a column for each named
+// column, in the order given. If no columns are named, all the numeric
+// columns are used. The values are copied.
+func (d *DataFrame) ToMatrix(cols ...string) (*mat.Dense, error) {
+	v, r, c, err := d.ToRowMajor(cols...)
+	if err != nil {
+		return nil, err
+	}
+
+	if r == 0 || c == 0 {
+		return nil, errors.New("cannot create a matrix with no rows or columns")
+	}
+
+	return mat.NewDense(r, c, v), nil
+}
+
+// ToRowMajor returns the values of the named columns in a single slice,
+// in row-major order, along with the number of rows and columns. Columns
+// are chosen as they are by ToMatrix.
+func (d *DataFrame) ToRowMajor(cols ...string) ([]float64, int, int, error) {
+	s, err := d.numericSeries(cols)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	r := d.rowCount()
+	v := make([]float64, r*len(s))
+	for j, c := range s {
+		for i, x := range c.Values {
+			v[i*len(s)+j] = x
+		}
+	}
+
+	return v, r, len(s), nil
+}
+
+// FromMatrix creates a DataFrame from a gonum matrix, with a numeric
+// Series for each column of the matrix. If names is nil, the columns are
+// named in the same way as NewDataFrame names columns without headers.
+func FromMatrix(m mat.Matrix, names []string) (*DataFrame, error) {
+	r, c := m.Dims()
+
+	if names == nil {
+		names = []string{}
+		for x := 0; x < c; x++ {
+			names = append(names, fmt.Sprintf("Column %v", x+1))
+		}
+	}
+
+	if len(names) != c {
+		return nil, fmt.Errorf("%d names were provided for a matrix with %d columns", len(names), c)
+	}
+
+	d := DataFrame{}
+	for j, n := range names {
+		v := make([]float64, r)
+		for i := range v {
+			v[i] = m.At(i, j)
+		}
+		d = append(d, &Series{Name: n, Values: v})
+	}
+
+	return &d, nil
+}
+
+// ToColumns returns a map of column names to copies of the values of
+// each numeric Series. Categorical Series are not included.
+func (d *DataFrame) ToColumns() map[string][]float64 {
+	m := map[string][]float64{}
+
+	for _, s := range *d {
+		if s.IsCategorical() == false {
+			m[s.Name] = append([]float64{}, s.Values...)
+		}
+	}
+
+	return m
+}
+
+// NewDataFrameFromMap creates a DataFrame with a numeric Series for each
+// entry in the map, holding a copy of the values. The columns are ordered
+// by name. All the slices must have the same length.
+func NewDataFrameFromMap(m map[string][]float64) (*DataFrame, error) {
+	names := []string{}
+	for n := range m {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	d := DataFrame{}
+	for _, n := range names {
+		if len(m[n]) != len(m[names[0]]) {
+			return nil, errors.New("not all columns have the same number of rows")
+		}
+		d = append(d, NewSeries(n, m[n]))
+	}
+
+	return &d, nil
+}
+
+// numericSeries returns the named Series, or all numeric Series
+// if no names are provided, returning an error if any of the named
+// Series are categorical.
+func (d *DataFrame) numericSeries(n []string) (DataFrame, error) {
+	if len(n) == 0 {
+		df := DataFrame{}
+		for _, s := range *d {
+			if s.IsCategorical() == false {
+				df = append(df, s)
+			}
+		}
+		return df, nil
+	}
+
+	df, err := d.seriesByName(n...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range df {
+		if s.IsCategorical() {
+			return nil, fmt.Errorf("Series %s is categorical", s.Name)
+		}
+	}
+
+	return df, nil
+}
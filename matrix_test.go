@@ -0,0 +1,88 @@
+package gander
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/gonum/mat"
+)
+
+func createMatrixTestData() *DataFrame {
+	return &DataFrame{
+		NewSeries("a", []float64{1, 2, 3}),
+		NewCategoricalSeries("b", []string{"x", "y", "x"}),
+		NewSeries("c", []float64{4, 5, 6}),
+	}
+}
+
+func TestToMatrix(t *testing.T) {
+	df := createMatrixTestData()
+
+	m, err := df.ToMatrix()
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, mat.Equal(mat.NewDense(3, 2, []float64{1, 4, 2, 5, 3, 6}), m), "matrix is not correct")
+
+	m, err = df.ToMatrix("c", "a")
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, mat.Equal(mat.NewDense(3, 2, []float64{4, 1, 5, 2, 6, 3}), m), "matrix is not correct")
+
+	m.Set(0, 0, 100)
+	assert.Equal(t, 4.0, (*df)[2].Values[0], "values should be copied")
+
+	_, err = df.ToMatrix("b")
+	assert.Equal(t, "Series b is categorical", err.Error(), "error message is not correct")
+
+	_, err = df.ToMatrix("z")
+	assert.Equal(t, "column 'z' does not exist in the DataFrame", err.Error(), "error message is not correct")
+
+	_, err = (&DataFrame{NewSeries("a", []float64{})}).ToMatrix()
+	assert.Equal(t, "cannot create a matrix with no rows or columns", err.Error(), "error message is not correct")
+}
+
+func TestToRowMajor(t *testing.T) {
+	v, r, c, err := createMatrixTestData().ToRowMajor("a", "c")
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []float64{1, 4, 2, 5, 3, 6}, v, "values are not correct")
+	assert.Equal(t, 3, r, "row count is not correct")
+	assert.Equal(t, 2, c, "column count is not correct")
+}
+
+func TestFromMatrix(t *testing.T) {
+	m := mat.NewDense(2, 2, []float64{1, 2, 3, 4})
+
+	df, err := FromMatrix(m, []string{"x", "y"})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"x", "y"}, df.ColumnNames(), "column names are not correct")
+	assert.Equal(t, []float64{2, 4}, (*df)[1].Values, "values are not correct")
+
+	df, err = FromMatrix(m.T(), nil)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"Column 1", "Column 2"}, df.ColumnNames(), "column names are not correct")
+	assert.Equal(t, []float64{3, 4}, (*df)[1].Values, "values are not correct")
+
+	_, err = FromMatrix(m, []string{"x"})
+	assert.Equal(t, "1 names were provided for a matrix with 2 columns", err.Error(), "error message is not correct")
+}
+
+func TestToColumns(t *testing.T) {
+	df := createMatrixTestData()
+	c := df.ToColumns()
+	assert.Equal(t, map[string][]float64{"a": {1, 2, 3}, "c": {4, 5, 6}}, c, "columns are not correct")
+
+	c["a"][0] = 100
+	assert.Equal(t, 1.0, (*df)[0].Values[0], "values should be copied")
+}
+
+func TestNewDataFrameFromMap(t *testing.T) {
+	v := []float64{1, 2}
+	df, err := NewDataFrameFromMap(map[string][]float64{"b": {3, 4}, "a": v})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"a", "b"}, df.ColumnNames(), "columns should be ordered by name")
+	assert.Equal(t, []float64{3, 4}, (*df)[1].Values, "values are not correct")
+
+	v[0] = 100
+	assert.Equal(t, 1.0, (*df)[0].Values[0], "values should be copied")
+
+	_, err = NewDataFrameFromMap(map[string][]float64{"a": {1}, "b": {1, 2}})
+	assert.Equal(t, "not all columns have the same number of rows", err.Error(), "error message is not correct")
+}
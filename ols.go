@@ -0,0 +1,282 @@
+package gander
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// An OLSResult holds a linear regression model fitted by OLS.
+// Terms names each coefficient, starting with the intercept. Each
+// categorical predictor is expanded into one term per category, except
+// the first, with names such as "sex[M]".
+type OLSResult struct {
+	Response     string
+	Terms        []string
+	Coefficients []float64
+	StdErrors    []float64
+	TStats       []float64
+	PValues      []float64
+	RSquared     float64
+	AdjRSquared  float64
+	Observations int
+	DF           int
+
+	predictors []string
+	levels     map[string][]string
+}
+
+// OLS fits a linear regression model by ordinary least squares, with
+// the column y as the response and the columns x as predictors. An
+// intercept is always included. Categorical predictors are dummy
+// encoded, using their first category as the baseline. Only the
+// categories that occur in the DataFrame are encoded, so categories
+// left with no rows, for example after DropRows, are ignored. Rows
+// with a missing (NaN) value in the response or any predictor are
+// left out of the fit, and are not counted in Observations.
+func OLS(d *DataFrame, y string, x []string) (*OLSResult, error) {
+	ys, err := d.seriesByName(y)
+	if err != nil {
+		return nil, err
+	}
+
+	if ys[0].IsCategorical() == true {
+		return nil, fmt.Errorf("Series %s is categorical", y)
+	}
+
+	xs, err := d.seriesByName(x...)
+	if err != nil {
+		return nil, err
+	}
+
+	c := append(ys, xs...)
+	c = *c.complete()
+	ys, xs = c[:1], c[1:]
+
+	r := OLSResult{Response: y, predictors: x, levels: map[string][]string{}}
+	for _, s := range xs {
+		if s.IsCategorical() == true {
+			r.levels[s.Name] = s.levels()
+		}
+	}
+
+	r.Terms = r.terms()
+	design, err := r.design(&c)
+	if err != nil {
+		return nil, err
+	}
+
+	n, p := len(design), len(r.Terms)
+	if n <= p {
+		return nil, fmt.Errorf("%v observations are not enough to fit %v terms", n, p)
+	}
+
+	xtx := make([][]float64, p)
+	xty := make([]float64, p)
+	for i := 0; i < p; i++ {
+		xtx[i] = make([]float64, p)
+		for j := 0; j < p; j++ {
+			for k := 0; k < n; k++ {
+				xtx[i][j] += design[k][i] * design[k][j]
+			}
+		}
+		for k := 0; k < n; k++ {
+			xty[i] += design[k][i] * ys[0].Values[k]
+		}
+	}
+
+	inv, err := invert(xtx)
+	if err != nil {
+		return nil, errors.New("predictors are perfectly collinear")
+	}
+
+	r.Coefficients = make([]float64, p)
+	for i := 0; i < p; i++ {
+		for j := 0; j < p; j++ {
+			r.Coefficients[i] += inv[i][j] * xty[j]
+		}
+	}
+
+	mu := ys[0].Mean()
+	rss, tss := 0.0, 0.0
+	for k := 0; k < n; k++ {
+		e := ys[0].Values[k] - dot(design[k], r.Coefficients)
+		rss += e * e
+		tss += (ys[0].Values[k] - mu) * (ys[0].Values[k] - mu)
+	}
+
+	r.Observations = n
+	r.DF = n - p
+	sigma2 := rss / float64(r.DF)
+
+	for i := 0; i < p; i++ {
+		se := math.Sqrt(sigma2 * inv[i][i])
+		t := r.Coefficients[i] / se
+		r.StdErrors = append(r.StdErrors, se)
+		r.TStats = append(r.TStats, t)
+		r.PValues = append(r.PValues, studentTPValue(t, float64(r.DF)))
+	}
+
+	r.RSquared = 1 - rss/tss
+	r.AdjRSquared = 1 - (1-r.RSquared)*float64(n-1)/float64(r.DF)
+
+	return &r, nil
+}
+
+// Predict returns a new Series holding the values predicted by the model
+// for each row of the DataFrame, which must contain all the predictors.
+// The prediction is NaN for rows with a missing value in any predictor.
+func (r *OLSResult) Predict(d *DataFrame) (*Series, error) {
+	design, err := r.design(d)
+	if err != nil {
+		return nil, err
+	}
+
+	p := []float64{}
+	for _, row := range design {
+		p = append(p, dot(row, r.Coefficients))
+	}
+
+	return NewSeries(r.Response, p), nil
+}
+
+// String returns a table of the fitted coefficients and their statistics.
+func (r *OLSResult) String() string {
+	w := len("(Intercept)")
+	for _, t := range r.Terms {
+		if len(t) > w {
+			w = len(t)
+		}
+	}
+
+	f := "%-" + strconv.Itoa(w) + "s  %12s  %12s  %10s  %10s\n"
+	output := fmt.Sprintf("OLS regression of %s, %v observations\n", r.Response, r.Observations)
+	output += fmt.Sprintf(f, "", "Coefficient", "Std. Error", "t", "P>|t|")
+
+	for i, t := range r.Terms {
+		output += fmt.Sprintf(f, t,
+			fmt.Sprintf("%.4f", r.Coefficients[i]),
+			fmt.Sprintf("%.4f", r.StdErrors[i]),
+			fmt.Sprintf("%.3f", r.TStats[i]),
+			fmt.Sprintf("%.4f", r.PValues[i]))
+	}
+
+	output += fmt.Sprintf("R-squared: %.4f, Adj. R-squared: %.4f\n", r.RSquared, r.AdjRSquared)
+
+	return output
+}
+
+func (r *OLSResult) terms() []string {
+	t := []string{"(Intercept)"}
+
+	for _, x := range r.predictors {
+		if l, ok := r.levels[x]; ok {
+			for _, v := range l[1:] {
+				t = append(t, fmt.Sprintf("%s[%s]", x, v))
+			}
+		} else {
+			t = append(t, x)
+		}
+	}
+
+	return t
+}
+
+// complete returns a copy of the DataFrame, keeping only the
+// rows that do not hold a missing (NaN) value in any column.
+func (d *DataFrame) complete() *DataFrame {
+	c := d.Clone()
+	keep := make([]bool, c.rowCount())
+
+	for i := range keep {
+		keep[i] = true
+		for _, v := range *c {
+			if isNA(v.Values[i]) == true {
+				keep[i] = false
+				break
+			}
+		}
+	}
+
+	c.keepRows(keep)
+
+	return c
+}
+
+// design returns the design matrix for the model, with a row for each
+// row of the DataFrame and a column for each term. Missing (NaN)
+// values of a categorical predictor are NaN in each of its terms.
+func (r *OLSResult) design(d *DataFrame) ([][]float64, error) {
+	xs, err := d.seriesByName(r.predictors...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range xs {
+		if _, ok := r.levels[s.Name]; ok != s.IsCategorical() {
+			return nil, fmt.Errorf("Series %s does not have the same type as when fitted", s.Name)
+		}
+	}
+
+	m := [][]float64{}
+	for i := 0; i < d.Rows(); i++ {
+		row := []float64{1}
+		for _, s := range xs {
+			l, ok := r.levels[s.Name]
+			if ok == false {
+				row = append(row, s.Values[i])
+				continue
+			}
+
+			if isNA(s.Values[i]) == true {
+				for range l[1:] {
+					row = append(row, math.NaN())
+				}
+				continue
+			}
+
+			v := s.categoricalLabels[s.Values[i]]
+			if containsString(v, l) == false {
+				return nil, fmt.Errorf("category '%s' of Series %s was not present when fitted", v, s.Name)
+			}
+			for _, x := range l[1:] {
+				if v == x {
+					row = append(row, 1)
+				} else {
+					row = append(row, 0)
+				}
+			}
+		}
+		m = append(m, row)
+	}
+
+	return m, nil
+}
+
+// levels returns the labels of the categories that occur in a
+// categorical Series, in the order of their categorical values.
+func (s *Series) levels() []string {
+	v := s.valid()
+	sort.Float64s(v)
+
+	l := []string{}
+	for i, x := range v {
+		if i == 0 || x != v[i-1] {
+			l = append(l, s.label(x))
+		}
+	}
+
+	return l
+}
+
+func dot(a, b []float64) float64 {
+	t := 0.0
+
+	for i := range a {
+		t += a[i] * b[i]
+	}
+
+	return t
+}
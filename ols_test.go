@@ -0,0 +1,163 @@
+package gander
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createRegressionData() *DataFrame {
+	df, _ := NewDataFrame([][]string{
+		{"x", "y", "g"},
+		{"1", "2", "a"},
+		{"2", "4", "b"},
+		{"3", "5", "a"},
+		{"4", "4", "b"},
+		{"5", "5", "a"},
+	})
+	return df
+}
+
+func TestOLSSimpleRegression(t *testing.T) {
+	r, err := OLS(createRegressionData(), "y", []string{"x"})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"(Intercept)", "x"}, r.Terms, "terms are not correct")
+	assert.InDelta(t, 2.2, r.Coefficients[0], 1e-10, "intercept is not correct")
+	assert.InDelta(t, 0.6, r.Coefficients[1], 1e-10, "slope is not correct")
+	assert.InDelta(t, 0.938083, r.StdErrors[0], 1e-6, "intercept std error is not correct")
+	assert.InDelta(t, 0.282843, r.StdErrors[1], 1e-6, "slope std error is not correct")
+	assert.InDelta(t, 2.121320, r.TStats[1], 1e-6, "t statistic is not correct")
+	assert.InDelta(t, 0.124027, r.PValues[1], 1e-6, "p-value is not correct")
+	assert.InDelta(t, 0.6, r.RSquared, 1e-10, "r-squared is not correct")
+	assert.InDelta(t, 0.466667, r.AdjRSquared, 1e-6, "adjusted r-squared is not correct")
+	assert.Equal(t, 3, r.DF, "degrees of freedom are not correct")
+}
+
+func TestOLSCategoricalPredictor(t *testing.T) {
+	df, _ := NewDataFrame([][]string{
+		{"y", "g"},
+		{"1", "a"},
+		{"5", "b"},
+		{"2", "a"},
+		{"6", "b"},
+		{"3", "a"},
+		{"7", "b"},
+	})
+	r, err := OLS(df, "y", []string{"g"})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"(Intercept)", "g[b]"}, r.Terms, "categorical predictor was not dummy encoded")
+	assert.InDelta(t, 2, r.Coefficients[0], 1e-10, "intercept is not correct")
+	assert.InDelta(t, 4, r.Coefficients[1], 1e-10, "dummy coefficient is not correct")
+}
+
+func TestOLSUnobservedCategory(t *testing.T) {
+	df, _ := NewDataFrame([][]string{
+		{"y", "g"},
+		{"1", "a"},
+		{"9", "c"},
+		{"5", "b"},
+		{"2", "a"},
+		{"6", "b"},
+		{"3", "a"},
+		{"7", "b"},
+	})
+	df.DropRows(1)
+	r, err := OLS(df, "y", []string{"g"})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"(Intercept)", "g[b]"}, r.Terms, "unobserved category should not be encoded")
+	assert.InDelta(t, 4, r.Coefficients[1], 1e-10, "dummy coefficient is not correct")
+}
+
+func TestOLSMissingValues(t *testing.T) {
+	df, _ := NewDataFrame([][]string{
+		{"x", "y", "g"},
+		{"1", "2", "a"},
+		{"2", "4", "b"},
+		{"", "9", "a"},
+		{"3", "5", "a"},
+		{"4", "4", "b"},
+		{"7", "", "b"},
+		{"5", "5", "a"},
+	})
+	r, err := OLS(df, "y", []string{"x"})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 5, r.Observations, "incomplete rows should not be counted")
+	assert.InDelta(t, 2.2, r.Coefficients[0], 1e-10, "intercept is not correct")
+	assert.InDelta(t, 0.6, r.Coefficients[1], 1e-10, "slope is not correct")
+	assert.InDelta(t, 0.6, r.RSquared, 1e-10, "r-squared is not correct")
+
+	p, err := r.Predict(df)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.InDelta(t, 2.8, p.Values[0], 1e-10, "prediction is not correct")
+	assert.Equal(t, true, math.IsNaN(p.Values[2]), "prediction for a missing value is not NaN")
+}
+
+func TestOLSMissingCategories(t *testing.T) {
+	df, _ := NewDataFrame([][]string{
+		{"y", "g"},
+		{"1", "a"},
+		{"5", "b"},
+		{"9", ""},
+		{"2", "a"},
+		{"6", "b"},
+		{"3", "a"},
+		{"7", "b"},
+	})
+	r, err := OLS(df, "y", []string{"g"})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 6, r.Observations, "incomplete rows should not be counted")
+	assert.Equal(t, []string{"(Intercept)", "g[b]"}, r.Terms, "terms are not correct")
+	assert.InDelta(t, 2, r.Coefficients[0], 1e-10, "intercept is not correct")
+	assert.InDelta(t, 4, r.Coefficients[1], 1e-10, "dummy coefficient is not correct")
+
+	p, err := r.Predict(df)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.InDelta(t, 6, p.Values[1], 1e-10, "prediction is not correct")
+	assert.Equal(t, true, math.IsNaN(p.Values[2]), "prediction for a missing category is not NaN")
+}
+
+func TestOLSPredict(t *testing.T) {
+	r, _ := OLS(createRegressionData(), "y", []string{"x", "g"})
+	df, _ := NewDataFrame([][]string{
+		{"g", "x"},
+		{"b", "1"},
+		{"a", "1"},
+	})
+	p, err := r.Predict(df)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.InDelta(t, p.Values[1]+r.Coefficients[2], p.Values[0], 1e-10, "dummy encoding does not use category labels")
+	assert.InDelta(t, r.Coefficients[0]+r.Coefficients[1], p.Values[1], 1e-10, "prediction is not correct")
+}
+
+func TestOLSPredictUnknownCategory(t *testing.T) {
+	r, _ := OLS(createRegressionData(), "y", []string{"x", "g"})
+	df, _ := NewDataFrame([][]string{
+		{"g", "x"},
+		{"c", "1"},
+	})
+	_, err := r.Predict(df)
+	assert.Equal(t, "category 'c' of Series g was not present when fitted", err.Error(), "")
+}
+
+func TestOLSCollinearPredictors(t *testing.T) {
+	df := createRegressionData()
+	*df = append(*df, NewSeries("x2", (*df)[0].Apply(func(x float64) float64 {
+		return 2 * x
+	})))
+	_, err := OLS(df, "y", []string{"x", "x2"})
+	assert.Equal(t, "predictors are perfectly collinear", err.Error(), "")
+}
+
+func TestOLSInvalidColumns(t *testing.T) {
+	_, err := OLS(createRegressionData(), "g", []string{"x"})
+	assert.Equal(t, "Series g is categorical", err.Error(), "")
+	_, err = OLS(createRegressionData(), "y", []string{"z"})
+	assert.Equal(t, "column 'z' does not exist in the DataFrame", err.Error(), "")
+}
+
+func TestStudentTPValue(t *testing.T) {
+	assert.InDelta(t, 0.05, studentTPValue(2.228139, 10), 1e-6, "p-value is not correct")
+	assert.InDelta(t, 0.05, studentTPValue(-1.959964, 1e6), 1e-5, "p-value is not correct")
+	assert.InDelta(t, 1, studentTPValue(0, 5), 1e-12, "p-value is not correct")
+}
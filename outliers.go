@@ -0,0 +1,151 @@
+package gander
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// An OutlierMethod is a method of detecting outliers in a Series.
+type OutlierMethod int
+
+const (
+	// OutlierZScore marks values more than threshold standard
+	// deviations from the mean.
+	OutlierZScore OutlierMethod = iota
+	// OutlierIQR marks values more than threshold times the interquartile
+	// range below the lower quartile or above the upper quartile.
+	OutlierIQR
+	// OutlierMAD marks values whose modified z-score, based on the median
+	// absolute deviation, is greater than threshold.
+	OutlierMAD
+)
+
+// Outliers returns a slice with an entry for each value, which is true
+// if the value is an outlier according to the provided method and threshold.
+// Commonly used thresholds are 3 for OutlierZScore, 1.5 for OutlierIQR and
+// 3.5 for OutlierMAD. Missing (NaN) values are never outliers, and are
+// not used to find the statistics that outliers are measured against.
+func (s *Series) Outliers(method OutlierMethod, threshold float64) ([]bool, error) {
+	if s.IsCategorical() == true {
+		return nil, fmt.Errorf("Series %s is categorical", s.Name)
+	}
+
+	r := make([]bool, len(s.Values))
+
+	v := NewSeries(s.Name, s.valid())
+	if len(v.Values) == 0 {
+		if method < OutlierZScore || method > OutlierMAD {
+			return nil, errors.New("unknown outlier method")
+		}
+		return r, nil
+	}
+
+	var test func(float64) bool
+
+	switch method {
+	case OutlierZScore:
+		mu, sigma := v.Mean(), v.StdDev()
+		test = func(x float64) bool {
+			return math.Abs(x-mu) > threshold*sigma
+		}
+	case OutlierIQR:
+		q1, q3 := v.Quantile(0.25), v.Quantile(0.75)
+		iqr := q3 - q1
+		test = func(x float64) bool {
+			return x < q1-threshold*iqr || x > q3+threshold*iqr
+		}
+	case OutlierMAD:
+		m := v.Median()
+		mad := NewSeries("", v.Apply(func(x float64) float64 {
+			return math.Abs(x - m)
+		})).Median()
+		// 0.6745 is the 0.75 quantile of the standard normal distribution,
+		// which makes the modified z-score comparable to a z-score
+		test = func(x float64) bool {
+			return 0.6745*math.Abs(x-m) > threshold*mad
+		}
+	default:
+		return nil, errors.New("unknown outlier method")
+	}
+
+	for i, x := range s.Values {
+		r[i] = isNA(x) == false && test(x)
+	}
+
+	return r, nil
+}
+
+// Clip limits the values in the Series to lie between lo and hi,
+// changing them in place. Missing (NaN) values are left unchanged.
+func (s *Series) Clip(lo, hi float64) error {
+	if s.IsCategorical() == true {
+		return fmt.Errorf("Series %s is categorical", s.Name)
+	}
+
+	s.Transform(func(x float64) float64 {
+		if x < lo {
+			return lo
+		}
+		if x > hi {
+			return hi
+		}
+		return x
+	})
+
+	return nil
+}
+
+// Winsorize clips the values in the Series to the lowerQ and upperQ
+// quantiles, so that extreme values are replaced by less extreme ones
+// rather than removed. The quantiles are found from the values that
+// are not missing.
+func (s *Series) Winsorize(lowerQ, upperQ float64) error {
+	if lowerQ < 0 || upperQ > 1 || lowerQ > upperQ {
+		return errors.New("quantiles must be between 0 and 1, with the lower quantile first")
+	}
+
+	if s.IsCategorical() == true {
+		return fmt.Errorf("Series %s is categorical", s.Name)
+	}
+
+	v := NewSeries(s.Name, s.valid())
+
+	return s.Clip(v.Quantile(lowerQ), v.Quantile(upperQ))
+}
+
+// DropOutliers removes all the rows that have an outlier in any of the
+// named columns, or in any non-categorical column if cols is empty.
+// Outliers are detected in each column before any rows are removed.
+func (d *DataFrame) DropOutliers(cols []string, method OutlierMethod, threshold float64) error {
+	df, err := d.seriesByName(cols...)
+	if err != nil {
+		return err
+	}
+
+	if len(cols) == 0 {
+		for _, v := range *d {
+			if v.IsCategorical() == false {
+				df = append(df, v)
+			}
+		}
+	}
+
+	outliers := make([][]bool, len(df))
+	errs := make([]error, len(df))
+	parallelFor(len(df), func(i int) {
+		outliers[i], errs[i] = df[i].Outliers(method, threshold)
+	})
+
+	drop := make([]bool, d.Rows())
+	for c, o := range outliers {
+		if errs[c] != nil {
+			return errs[c]
+		}
+		for i, x := range o {
+			drop[i] = drop[i] || x
+		}
+	}
+
+	return d.dropRowsMask(drop)
+}
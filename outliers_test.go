@@ -0,0 +1,113 @@
+package gander
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createOutlierTestSeries() *Series {
+	return NewSeries("x", []float64{10, 12, 11, 13, 12, 11, 10, 12, 11, 100})
+}
+
+func TestOutliersZScore(t *testing.T) {
+	s := createOutlierTestSeries()
+	o, err := s.Outliers(OutlierZScore, 2)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []bool{false, false, false, false, false, false, false, false, false, true}, o, "outliers not detected")
+}
+
+func TestOutliersIQR(t *testing.T) {
+	s := createOutlierTestSeries()
+	o, _ := s.Outliers(OutlierIQR, 1.5)
+	assert.Equal(t, []bool{false, false, false, false, false, false, false, false, false, true}, o, "outliers not detected")
+}
+
+func TestOutliersMAD(t *testing.T) {
+	s := createOutlierTestSeries()
+	o, _ := s.Outliers(OutlierMAD, 3.5)
+	assert.Equal(t, []bool{false, false, false, false, false, false, false, false, false, true}, o, "outliers not detected")
+}
+
+func TestOutliersCategoricalData(t *testing.T) {
+	s := createTestCategoricalSeries()
+	_, err := s.Outliers(OutlierIQR, 1.5)
+	assert.Equal(t, "Series MySeries is categorical", err.Error(), "")
+}
+
+func TestOutliersMissingValues(t *testing.T) {
+	s := NewSeries("x", []float64{1, 2, 3, 4, 100, math.NaN()})
+	e := []bool{false, false, false, false, true, false}
+
+	o, err := s.Outliers(OutlierZScore, 1.5)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, e, o, "outliers not detected")
+	o, _ = s.Outliers(OutlierIQR, 1.5)
+	assert.Equal(t, e, o, "outliers not detected")
+	o, _ = s.Outliers(OutlierMAD, 3.5)
+	assert.Equal(t, e, o, "outliers not detected")
+
+	o, err = NewSeries("x", []float64{math.NaN()}).Outliers(OutlierMAD, 3.5)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []bool{false}, o, "missing values should not be outliers")
+}
+
+func TestClip(t *testing.T) {
+	s := createTestSeries()
+	err := s.Clip(1, 4)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []float64{1, 2, 4, 1, 4, 1, 3, 4, 3, 4}, s.Values, "values not clipped")
+}
+
+func TestClipCategoricalData(t *testing.T) {
+	s := createTestCategoricalSeries()
+	v := append([]float64{}, s.Values...)
+	err := s.Clip(0, 1)
+	assert.Equal(t, "Series MySeries is categorical", err.Error(), "")
+	assert.Equal(t, v, s.Values, "categorical data has been changed")
+}
+
+func TestWinsorizeMissingValues(t *testing.T) {
+	s := NewSeries("x", []float64{math.NaN(), 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	err := s.Winsorize(0.1, 0.9)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, math.IsNaN(s.Values[0]), "missing value should stay missing")
+	assert.Equal(t, []float64{1, 1, 2, 3, 4, 5, 6, 7, 8, 9, 9}, s.Values[1:], "values not winsorized")
+}
+
+func TestWinsorize(t *testing.T) {
+	s := NewSeries("x", []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	err := s.Winsorize(0.1, 0.9)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []float64{1, 1, 2, 3, 4, 5, 6, 7, 8, 9, 9}, s.Values, "values not winsorized")
+}
+
+func TestWinsorizeInvalidQuantiles(t *testing.T) {
+	s := createTestSeries()
+	err := s.Winsorize(0.9, 0.1)
+	assert.Equal(t, "quantiles must be between 0 and 1, with the lower quantile first", err.Error(), "")
+}
+
+func TestDropOutliersByName(t *testing.T) {
+	df := DataFrame{
+		NewSeries("a", []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}),
+		createOutlierTestSeries(),
+	}
+	df.DropColumns(0)
+	err := df.DropOutliers([]string{"x"}, OutlierIQR, 1.5)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 9, df.Rows(), "wrong number of rows remaining")
+	assert.Equal(t, 13.0, df[0].Max(), "outlier was not removed")
+}
+
+func TestDropOutliersAllColumns(t *testing.T) {
+	df := DataFrame{
+		NewSeries("a", []float64{-50, 2, 3, 4, 5, 6, 7, 8, 9, 10}),
+		createOutlierTestSeries(),
+		NewCategoricalSeries("c", []string{"a", "a", "a", "a", "a", "a", "a", "a", "a", "b"}),
+	}
+	err := df.DropOutliers(nil, OutlierIQR, 1.5)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 8, df.Rows(), "wrong number of rows remaining")
+}
@@ -0,0 +1,109 @@
+package gander
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// minChunkSize is the fewest rows that are processed
+// by each goroutine in a chunk-wise operation.
+const minChunkSize = 16384
+
+var workers = struct {
+	sync.Mutex
+	n   int
+	sem chan struct{}
+}{}
+
+func init() {
+	SetParallelism(0)
+}
+
+// SetParallelism sets the maximum number of goroutines used by column-wise
+// and chunk-wise operations, such as creating a DataFrame, Describe and
+// Standardize. The limit is shared by all operations running at the same
+// time. If n is less than 1, runtime.GOMAXPROCS is used. A value of 1 runs
+// every operation in the calling goroutine.
+func SetParallelism(n int) {
+	if n < 1 {
+		n = runtime.GOMAXPROCS(0)
+	}
+
+	workers.Lock()
+	defer workers.Unlock()
+
+	workers.n = n
+	workers.sem = make(chan struct{}, n-1)
+}
+
+// GetParallelism returns the maximum number of goroutines used by
+// column-wise and chunk-wise operations.
+func GetParallelism() int {
+	workers.Lock()
+	defer workers.Unlock()
+
+	return workers.n
+}
+
+// parallelFor calls fn for each number from 0 to n-1. The calling
+// goroutine is joined by as many extra goroutines as the parallelism
+// limit allows, so nested calls never exceed the limit, and run in the
+// calling goroutine if no more goroutines are available.
+func parallelFor(n int, fn func(i int)) {
+	workers.Lock()
+	sem := workers.sem
+	workers.Unlock()
+
+	next := int64(-1)
+	work := func() {
+		for i := int(atomic.AddInt64(&next, 1)); i < n; i = int(atomic.AddInt64(&next, 1)) {
+			fn(i)
+		}
+	}
+
+	var wg sync.WaitGroup
+
+helpers:
+	for w := 1; w < n; w++ {
+		select {
+		case sem <- struct{}{}:
+			wg.Add(1)
+			go func() {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				work()
+			}()
+		default:
+			break helpers
+		}
+	}
+
+	work()
+	wg.Wait()
+}
+
+// parallelChunks splits the numbers from 0 to n-1 into chunks
+// and calls fn with the start and end of each chunk, as parallelFor
+// does.
+func parallelChunks(n int, fn func(start, end int)) {
+	chunks := (n + minChunkSize - 1) / minChunkSize
+
+	parallelFor(chunks, func(i int) {
+		end := (i + 1) * minChunkSize
+		if end > n {
+			end = n
+		}
+		fn(i*minChunkSize, end)
+	})
+}
+
+// forEachSeries calls fn for each Series in the DataFrame, as
+// parallelFor does.
+func (d *DataFrame) forEachSeries(fn func(s *Series)) {
+	parallelFor(d.Columns(), func(i int) {
+		fn((*d)[i])
+	})
+}
@@ -0,0 +1,161 @@
+package gander
+
+import (
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetParallelism(t *testing.T) {
+	defer SetParallelism(0)
+
+	SetParallelism(3)
+	assert.Equal(t, 3, GetParallelism(), "parallelism is not correct")
+
+	SetParallelism(0)
+	assert.Equal(t, runtime.GOMAXPROCS(0), GetParallelism(), "parallelism should default to GOMAXPROCS")
+}
+
+func TestParallelForCallsEachIndexOnce(t *testing.T) {
+	defer SetParallelism(0)
+
+	for _, p := range []int{1, 2, 8} {
+		SetParallelism(p)
+		c := make([]int32, 1000)
+		parallelFor(len(c), func(i int) {
+			atomic.AddInt32(&c[i], 1)
+		})
+		for i, v := range c {
+			if v != 1 {
+				t.Fatalf("index %d was called %d times with parallelism %d", i, v, p)
+			}
+		}
+	}
+}
+
+func TestParallelForIsBounded(t *testing.T) {
+	defer SetParallelism(0)
+	SetParallelism(3)
+
+	running, max := int32(0), int32(0)
+	var mu sync.Mutex
+	task := func(int) {
+		n := atomic.AddInt32(&running, 1)
+		mu.Lock()
+		if n > max {
+			max = n
+		}
+		mu.Unlock()
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&running, -1)
+	}
+
+	parallelFor(10, func(int) {
+		parallelFor(10, task)
+	})
+
+	assert.Equal(t, true, max <= 3, "more goroutines ran than the parallelism allows")
+	assert.Equal(t, true, max > 1, "tasks should run concurrently")
+}
+
+func TestParallelChunks(t *testing.T) {
+	n := minChunkSize*2 + 5
+	c := make([]int32, n)
+	parallelChunks(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			atomic.AddInt32(&c[i], 1)
+		}
+	})
+
+	for i, v := range c {
+		if v != 1 {
+			t.Fatalf("row %d was processed %d times", i, v)
+		}
+	}
+}
+
+func TestCreateSeriesInChunks(t *testing.T) {
+	defer SetParallelism(0)
+	SetParallelism(4)
+
+	data := [][]string{{"a"}}
+	for i := 0; i < minChunkSize*3; i++ {
+		data = append(data, []string{strconv.Itoa(i)})
+	}
+
+	df, err := NewDataFrame(data)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, false, (*df)[0].IsCategorical(), "Series should not be categorical")
+	assert.Equal(t, float64(minChunkSize*3-1), (*df)[0].Values[minChunkSize*3-1], "value is not correct")
+
+	data[len(data)-1][0] = "x"
+	df, _ = NewDataFrame(data)
+	assert.Equal(t, true, (*df)[0].IsCategorical(), "a value in the last chunk should make the Series categorical")
+	assert.Equal(t, "x", (*df)[0].label((*df)[0].Values[minChunkSize*3-1]), "label is not correct")
+}
+
+func createWideTestData(rows int, cols int) [][]string {
+	data := [][]string{}
+
+	h := []string{}
+	for c := 0; c < cols; c++ {
+		h = append(h, "c"+strconv.Itoa(c))
+	}
+	data = append(data, h)
+
+	for r := 0; r < rows; r++ {
+		row := []string{}
+		for c := 0; c < cols; c++ {
+			row = append(row, strconv.Itoa(r*c%97))
+		}
+		data = append(data, row)
+	}
+
+	return data
+}
+
+func TestParallelismDoesNotChangeResults(t *testing.T) {
+	defer SetParallelism(0)
+	data := createWideTestData(100, 50)
+
+	SetParallelism(1)
+	serial, _ := NewDataFrame(data)
+	serial.Standardize()
+
+	SetParallelism(8)
+	parallel, _ := NewDataFrame(data)
+	parallel.Standardize()
+
+	assert.Equal(t, serial, parallel, "results should not depend on the parallelism")
+
+	s, p := serial.Describe(), parallel.Describe()
+	for i := range s {
+		s[i].Mode, p[i].Mode = nil, nil
+	}
+	assert.Equal(t, s, p, "results should not depend on the parallelism")
+}
+
+func benchmarkNewDataFrameAndDescribe(b *testing.B, p int) {
+	defer SetParallelism(0)
+	SetParallelism(p)
+	data := createWideTestData(10000, 100)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		df, _ := NewDataFrame(data)
+		df.Describe()
+	}
+}
+
+func BenchmarkNewDataFrameAndDescribeSerial(b *testing.B) {
+	benchmarkNewDataFrameAndDescribe(b, 1)
+}
+
+func BenchmarkNewDataFrameAndDescribeParallel(b *testing.B) {
+	benchmarkNewDataFrameAndDescribe(b, 0)
+}
@@ -0,0 +1,150 @@
+package gander
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+)
+
+// ParquetCompression specifies the compression codec used
+// when writing a Parquet file.
+type ParquetCompression int
+
+const (
+	// ParquetUncompressed writes the data without compression.
+	ParquetUncompressed ParquetCompression = iota
+	// ParquetSnappy compresses the data with Snappy.
+	ParquetSnappy
+	// ParquetGzip compresses the data with gzip.
+	ParquetGzip
+	// ParquetZstd compresses the data with Zstandard.
+	ParquetZstd
+)
+
+// LoadParquet creates a DataFrame by loading a Parquet file from a
+// specific file system path. Only the named columns are read, in the
+// order they are given, or all columns if columns is empty.
+func LoadParquet(path string, columns []string) (*DataFrame, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	i, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return LoadParquetFromReader(f, i.Size(), columns)
+}
+
+// LoadParquetFromReader creates a DataFrame by loading a Parquet file of
+// the provided size from r. Only the named columns are read, in the order
+// they are given, or all columns if columns is empty.
+//
+// String and binary columns become categorical Series. Numeric and boolean
+// columns become numeric Series, as do date and timestamp columns, which
+// are converted to Unix timestamps in seconds. Nulls become NaN. Nested
+// columns, such as structs and lists, are not supported and return an error.
+func LoadParquetFromReader(r io.ReaderAt, size int64, columns []string) (*DataFrame, error) {
+	pf, err := file.NewParquetReader(io.NewSectionReader(r, 0, size))
+	if err != nil {
+		return nil, err
+	}
+
+	defer pf.Close()
+
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []pqarrow.SchemaField{}
+	for _, c := range columns {
+		found := false
+		for _, f := range fr.Manifest.Fields {
+			if f.Field.Name == c {
+				fields = append(fields, f)
+				found = true
+				break
+			}
+		}
+		if found == false {
+			return nil, fmt.Errorf("column '%s' does not exist in the Parquet file", c)
+		}
+	}
+
+	if len(columns) == 0 {
+		fields = fr.Manifest.Fields
+	}
+
+	// ReadRowGroups takes the indices of leaf columns, which only
+	// match the positions of the fields if no columns are nested
+	indices := []int{}
+	for _, f := range fields {
+		if f.IsLeaf() == false {
+			return nil, fmt.Errorf("column '%s' is nested, which is not supported", f.Field.Name)
+		}
+		indices = append(indices, f.ColIndex)
+	}
+
+	groups := []int{}
+	for i := 0; i < pf.NumRowGroups(); i++ {
+		groups = append(groups, i)
+	}
+
+	t, err := fr.ReadRowGroups(context.Background(), indices, groups)
+	if err != nil {
+		return nil, err
+	}
+
+	defer t.Release()
+
+	return dataFrameFromArrow(t)
+}
+
+// WriteParquet writes the DataFrame to w in Parquet format, using the
+// provided compression. Categorical Series are written as dictionary
+// encoded UTF-8 byte array columns. Numeric Series are written as INT64
+// columns if every value is a whole number, or DOUBLE columns otherwise.
+// Missing (NaN) values are written as nulls.
+func (d *DataFrame) WriteParquet(w io.Writer, compression ParquetCompression) error {
+	codecs := map[ParquetCompression]compress.Compression{
+		ParquetUncompressed: compress.Codecs.Uncompressed,
+		ParquetSnappy:       compress.Codecs.Snappy,
+		ParquetGzip:         compress.Codecs.Gzip,
+		ParquetZstd:         compress.Codecs.Zstd,
+	}
+
+	c, ok := codecs[compression]
+	if ok == false {
+		return fmt.Errorf("unknown Parquet compression %d", compression)
+	}
+
+	r := d.arrowRecord(memory.DefaultAllocator, true)
+	defer r.Release()
+
+	// The Parquet writer closes w if it is an io.Closer,
+	// so hide any Close method from it.
+	props := parquet.NewWriterProperties(parquet.WithCompression(c))
+	fw, err := pqarrow.NewFileWriter(r.Schema(), struct{ io.Writer }{w}, props, pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema()))
+	if err != nil {
+		return err
+	}
+
+	if err := fw.Write(r); err != nil {
+		fw.Close()
+		return err
+	}
+
+	return fw.Close()
+}
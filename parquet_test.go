@@ -0,0 +1,166 @@
+package gander
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+)
+
+func createParquetTestData() *DataFrame {
+	return &DataFrame{
+		NewSeries("a", []float64{1.5, math.NaN(), 3}),
+		NewSeries("b", []float64{1, 2, math.NaN()}),
+		NewCategoricalSeries("c", []string{"x", "y", "x"}),
+	}
+}
+
+func TestWriteParquetRoundTrip(t *testing.T) {
+	for _, c := range []ParquetCompression{ParquetUncompressed, ParquetSnappy, ParquetGzip, ParquetZstd} {
+		b := bytes.Buffer{}
+		err := createParquetTestData().WriteParquet(&b, c)
+		assert.Equal(t, nil, err, "error is not nil")
+
+		df, err := LoadParquetFromReader(bytes.NewReader(b.Bytes()), int64(b.Len()), nil)
+		assert.Equal(t, nil, err, "error is not nil")
+		assert.Equal(t, []string{"a", "b", "c"}, df.ColumnNames(), "column names are not correct")
+		assert.Equal(t, true, valuesMatch([]float64{1.5, na, 3}, (*df)[0].Values), "values are not correct")
+		assert.Equal(t, true, valuesMatch([]float64{1, 2, na}, (*df)[1].Values), "values are not correct")
+		assert.Equal(t, true, (*df)[2].IsCategorical(), "Series should be categorical")
+		assert.Equal(t, "y", (*df)[2].label((*df)[2].Values[1]), "label is not correct")
+	}
+}
+
+func TestWriteParquetMissingCategory(t *testing.T) {
+	s := NewCategoricalSeries("c", []string{"x", "y"})
+	s.Values[0] = math.NaN()
+	b := bytes.Buffer{}
+	(&DataFrame{s}).WriteParquet(&b, ParquetSnappy)
+
+	df, err := LoadParquetFromReader(bytes.NewReader(b.Bytes()), int64(b.Len()), nil)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, math.IsNaN((*df)[0].Values[0]), "missing value should be NaN")
+	assert.Equal(t, "y", (*df)[0].label((*df)[0].Values[1]), "label is not correct")
+}
+
+func TestWriteParquetTypes(t *testing.T) {
+	b := bytes.Buffer{}
+	createParquetTestData().WriteParquet(&b, ParquetSnappy)
+
+	pf, err := file.NewParquetReader(bytes.NewReader(b.Bytes()))
+	assert.Equal(t, nil, err, "error is not nil")
+	defer pf.Close()
+
+	rg := pf.MetaData().RowGroup(0)
+	types := []parquet.Type{}
+	for i := 0; i < 3; i++ {
+		c, _ := rg.ColumnChunk(i)
+		types = append(types, c.Type())
+		assert.Equal(t, compress.Codecs.Snappy, c.Compression(), "compression is not correct")
+	}
+	assert.Equal(t, []parquet.Type{parquet.Types.Double, parquet.Types.Int64, parquet.Types.ByteArray}, types, "column types are not correct")
+
+	c, _ := rg.ColumnChunk(2)
+	assert.Equal(t, true, c.HasDictionaryPage(), "categorical column should be dictionary encoded")
+}
+
+func TestLoadParquetColumns(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "gander")
+	defer os.RemoveAll(dir)
+	p := filepath.Join(dir, "test.parquet")
+
+	f, _ := os.Create(p)
+	createParquetTestData().WriteParquet(f, ParquetUncompressed)
+	f.Close()
+
+	df, err := LoadParquet(p, []string{"c", "a"})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"c", "a"}, df.ColumnNames(), "column names are not correct")
+
+	_, err = LoadParquet(p, []string{"z"})
+	assert.Equal(t, "column 'z' does not exist in the Parquet file", err.Error(), "error message is not correct")
+}
+
+func TestLoadParquetNestedColumns(t *testing.T) {
+	mem := memory.DefaultAllocator
+	point := arrow.StructOf(
+		arrow.Field{Name: "x", Type: arrow.PrimitiveTypes.Float64},
+		arrow.Field{Name: "y", Type: arrow.PrimitiveTypes.Float64},
+	)
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "p", Type: point},
+		{Name: "v", Type: arrow.PrimitiveTypes.Float64},
+	}, nil)
+
+	rb := array.NewRecordBuilder(mem, schema)
+	defer rb.Release()
+	sb := rb.Field(0).(*array.StructBuilder)
+	for i := 0; i < 2; i++ {
+		sb.Append(true)
+		sb.FieldBuilder(0).(*array.Float64Builder).Append(float64(i))
+		sb.FieldBuilder(1).(*array.Float64Builder).Append(float64(i * 10))
+	}
+	rb.Field(1).(*array.Float64Builder).AppendValues([]float64{5, 6}, nil)
+	r := rb.NewRecord()
+	defer r.Release()
+
+	b := bytes.Buffer{}
+	fw, _ := pqarrow.NewFileWriter(schema, &b, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
+	fw.Write(r)
+	fw.Close()
+
+	df, err := LoadParquetFromReader(bytes.NewReader(b.Bytes()), int64(b.Len()), []string{"v"})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"v"}, df.ColumnNames(), "column names are not correct")
+	assert.Equal(t, []float64{5, 6}, (*df)[0].Values, "the wrong column was read")
+
+	_, err = LoadParquetFromReader(bytes.NewReader(b.Bytes()), int64(b.Len()), nil)
+	assert.Equal(t, "column 'p' is nested, which is not supported", err.Error(), "error message is not correct")
+}
+
+func TestLoadParquetTypes(t *testing.T) {
+	mem := memory.DefaultAllocator
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+		{Name: "f", Type: arrow.PrimitiveTypes.Float32},
+		{Name: "b", Type: arrow.FixedWidthTypes.Boolean},
+		{Name: "s", Type: arrow.BinaryTypes.String},
+		{Name: "t", Type: &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "UTC"}},
+	}, nil)
+
+	rb := array.NewRecordBuilder(mem, schema)
+	defer rb.Release()
+	rb.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 0}, []bool{true, false})
+	rb.Field(1).(*array.Float32Builder).AppendValues([]float32{0.5, 2}, nil)
+	rb.Field(2).(*array.BooleanBuilder).AppendValues([]bool{true, false}, nil)
+	rb.Field(3).(*array.StringBuilder).AppendValues([]string{"p", "q"}, nil)
+	ts := time.Date(2020, 1, 1, 0, 0, 1, 500000000, time.UTC).UnixMilli()
+	rb.Field(4).(*array.TimestampBuilder).AppendValues([]arrow.Timestamp{arrow.Timestamp(ts), 0}, nil)
+	r := rb.NewRecord()
+	defer r.Release()
+
+	b := bytes.Buffer{}
+	fw, _ := pqarrow.NewFileWriter(schema, &b, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
+	fw.Write(r)
+	fw.Close()
+
+	df, err := LoadParquetFromReader(bytes.NewReader(b.Bytes()), int64(b.Len()), nil)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, valuesMatch([]float64{1, na}, (*df)[0].Values), "int values are not correct")
+	assert.Equal(t, []float64{0.5, 2}, (*df)[1].Values, "float values are not correct")
+	assert.Equal(t, []float64{1, 0}, (*df)[2].Values, "bool values are not correct")
+	assert.Equal(t, true, (*df)[3].IsCategorical(), "string column should be categorical")
+	assert.Equal(t, []float64{1577836801.5, 0}, (*df)[4].Values, "timestamp values are not correct")
+}
@@ -1,7 +1,9 @@
 package gander
 
 import (
+	"math"
 	"strconv"
+	"sync/atomic"
 )
 
 func isNumeric(v string) bool {
@@ -29,7 +31,8 @@ func hasHeaderRow(data [][]string) bool {
 }
 
 func hasCategoricalData(data [][]string, column int) bool {
-	// is any value in the column not numeric
+	// is any value in the column not numeric, ignoring empty
+	// values, which are missing
 	startRow := 0
 
 	if hasHeaderRow(data) == true {
@@ -37,7 +40,7 @@ func hasCategoricalData(data [][]string, column int) bool {
 	}
 
 	for r := startRow; r < len(data); r++ {
-		if isNumeric(data[r][column]) == false {
+		if data[r][column] != "" && isNumeric(data[r][column]) == false {
 			return true
 		}
 	}
@@ -62,21 +65,44 @@ func columnCountsMatch(data [][]string) bool {
 	return true
 }
 
+// createSeries converts a column of data to a Series, parsing the
+// values in chunks of rows concurrently. The Series is categorical
+// if any value cannot be parsed, as decided by hasCategoricalData.
+// Empty values are missing, and are held as NaN.
 func createSeries(name string, data [][]string, column int) *Series {
-	if hasCategoricalData(data, column) {
-		values := []string{}
-		for _, v := range data {
-			values = append(values, v[column])
-		}
-		return NewCategoricalSeries(name, values)
+	startRow := 0
+	if hasHeaderRow(data) == true {
+		startRow = 1
 	}
 
-	values := []float64{}
+	values := make([]float64, len(data))
+	categorical := int32(0)
+
+	parallelChunks(len(data), func(start, end int) {
+		for r := start; r < end && atomic.LoadInt32(&categorical) == 0; r++ {
+			if data[r][column] == "" {
+				values[r] = math.NaN()
+				continue
+			}
+			value, err := strconv.ParseFloat(data[r][column], 64)
+			if err != nil && r >= startRow {
+				atomic.StoreInt32(&categorical, 1)
+			}
+			values[r] = value
+		}
+	})
 
-	for _, v := range data {
-		value, _ := strconv.ParseFloat(v[column], 64)
-		values = append(values, value)
+	if categorical == 1 {
+		s := NewCategoricalSeries(name, nil)
+		for _, v := range data {
+			if v[column] == "" {
+				s.Values = append(s.Values, math.NaN())
+				continue
+			}
+			s.appendLabel(v[column])
+		}
+		return s
 	}
 
-	return NewSeries(name, values)
+	return &Series{Name: name, Values: values}
 }
@@ -2,6 +2,7 @@ package gander
 
 import (
 	"github.com/stretchr/testify/assert"
+	"math"
 	"testing"
 )
 
@@ -19,3 +20,14 @@ func TestDetectHeaderRowAbsentWithMixedHeaders(t *testing.T) {
 	b := hasHeaderRow(createSampleDataWithMixedHeaders())
 	assert.Equal(t, false, b, "header row not detected")
 }
+
+func TestCreateSeriesWithEmptyValues(t *testing.T) {
+	data := [][]string{{"a", "b"}, {"1", "x"}, {"", ""}, {"3", "y"}}
+	df, err := NewDataFrame(data)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, false, (*df)[0].IsCategorical(), "empty values should not make a Series categorical")
+	assert.Equal(t, true, math.IsNaN((*df)[0].Values[1]), "empty value should be missing")
+	assert.Equal(t, true, math.IsNaN((*df)[1].Values[1]), "empty category should be missing")
+	assert.Equal(t, 2, len((*df)[1].categoricalLabels), "empty value should not be a category")
+	assert.Equal(t, false, hasCategoricalData(data[1:], 0), "empty values should not be categorical")
+}
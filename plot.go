@@ -0,0 +1,584 @@
+package gander
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+)
+
+// A Plotter creates charts from the columns of a DataFrame.
+type Plotter struct {
+	d *DataFrame
+}
+
+// A Chart is an SVG chart of data from a DataFrame. Any error that
+// occurs while creating the chart is returned when it is written. The
+// title, axis labels and size can be changed before it is written.
+type Chart struct {
+	Title  string
+	XLabel string
+	YLabel string
+	Width  int
+	Height int
+
+	kind       chartKind
+	series     []plotSeries
+	categories []string
+	bars       []float64
+	boxes      []boxStats
+	hist       *Histogram
+	err        error
+}
+
+type chartKind int
+
+const (
+	scatterChart chartKind = iota
+	lineChart
+	barChart
+	histogramChart
+	boxChart
+)
+
+type plotSeries struct {
+	name string
+	x    []float64
+	y    []float64
+}
+
+type boxStats struct {
+	q1, median, q3 float64
+	lo, hi         float64
+	outliers       []float64
+}
+
+// newBoxStats finds the quartiles of the sorted values v, the whiskers
+// at the most extreme values within 1.5 times the interquartile range
+// of the box, and the values beyond the whiskers.
+func newBoxStats(v []float64) boxStats {
+	b := boxStats{q1: quantile(v, 0.25), median: quantile(v, 0.5), q3: quantile(v, 0.75)}
+	iqr := b.q3 - b.q1
+	b.lo, b.hi = b.q1, b.q3
+
+	for _, x := range v {
+		switch {
+		case x < b.q1-1.5*iqr || x > b.q3+1.5*iqr:
+			b.outliers = append(b.outliers, x)
+		case x < b.lo:
+			b.lo = x
+		case x > b.hi:
+			b.hi = x
+		}
+	}
+
+	return b
+}
+
+// palette holds the colours used for each series in turn.
+var palette = []string{
+	"#1f77b4", "#ff7f0e", "#2ca02c", "#d62728", "#9467bd",
+	"#8c564b", "#e377c2", "#7f7f7f", "#bcbd22", "#17becf",
+}
+
+const (
+	marginLeft   = 70
+	marginRight  = 20
+	marginTop    = 40
+	marginBottom = 55
+	legendWidth  = 120
+)
+
+// Plot returns a Plotter for creating charts from the DataFrame.
+func (d *DataFrame) Plot() *Plotter {
+	return &Plotter{d: d}
+}
+
+// Scatter creates a scatter chart of the column y against the column x.
+func (p *Plotter) Scatter(x, y string) *Chart {
+	c := p.newChart(scatterChart, x, y)
+	s, err := p.numeric(x, y)
+	if err != nil {
+		c.err = err
+		return c
+	}
+
+	c.series = []plotSeries{{name: y, x: s[0].Values, y: s[1].Values}}
+	return c
+}
+
+// ScatterBy creates a scatter chart of the column y against the column x,
+// with the points coloured by the categories of the column group.
+func (p *Plotter) ScatterBy(x, y, group string) *Chart {
+	c := p.newChart(scatterChart, x, y)
+	s, err := p.numeric(x, y)
+	if err != nil {
+		c.err = err
+		return c
+	}
+
+	g, err := p.d.seriesByName(group)
+	if err != nil {
+		c.err = err
+		return c
+	}
+
+	if g[0].IsCategorical() == false {
+		c.err = fmt.Errorf("Series %s is not categorical", group)
+		return c
+	}
+
+	for _, u := range g[0].Unique().Values {
+		ps := plotSeries{name: g[0].label(u)}
+		for i, v := range g[0].Values {
+			if v == u {
+				ps.x = append(ps.x, s[0].Values[i])
+				ps.y = append(ps.y, s[1].Values[i])
+			}
+		}
+		c.series = append(c.series, ps)
+	}
+
+	return c
+}
+
+// Line creates a line chart of each of the columns y against the column
+// x. The points of each line are joined in order of x.
+func (p *Plotter) Line(x string, y ...string) *Chart {
+	c := p.newChart(lineChart, x, strings.Join(y, ", "))
+	if len(y) == 0 {
+		c.err = errors.New("at least one column must be plotted")
+		return c
+	}
+
+	s, err := p.numeric(append([]string{x}, y...)...)
+	if err != nil {
+		c.err = err
+		return c
+	}
+
+	idx := make([]int, len(s[0].Values))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return s[0].Values[idx[i]] < s[0].Values[idx[j]]
+	})
+
+	for _, v := range s[1:] {
+		ps := plotSeries{name: v.Name}
+		for _, i := range idx {
+			ps.x = append(ps.x, s[0].Values[i])
+			ps.y = append(ps.y, v.Values[i])
+		}
+		c.series = append(c.series, ps)
+	}
+
+	return c
+}
+
+// Bar creates a bar chart of the number of values in each category
+// of the categorical column col. Missing (NaN) values are not counted.
+func (p *Plotter) Bar(col string) *Chart {
+	c := p.newChart(barChart, col, "Count")
+	s, err := p.d.seriesByName(col)
+	if err != nil {
+		c.err = err
+		return c
+	}
+
+	if s[0].IsCategorical() == false {
+		c.err = fmt.Errorf("Series %s is not categorical", col)
+		return c
+	}
+
+	for _, v := range s[0].ValueCounts(false, false) {
+		if isFinite(v.Value) == true {
+			c.categories = append(c.categories, v.Label)
+			c.bars = append(c.bars, float64(v.Count))
+		}
+	}
+
+	return c
+}
+
+// Histogram creates a histogram of the column col. If bins is not
+// greater than zero, the number of bins is chosen using Sturges' rule.
+// Missing (NaN) and infinite values are not counted.
+func (p *Plotter) Histogram(col string, bins int) *Chart {
+	c := p.newChart(histogramChart, col, "Count")
+	s, err := p.numeric(col)
+	if err != nil {
+		c.err = err
+		return c
+	}
+
+	if bins > 0 {
+		c.hist, c.err = s[0].Histogram(bins)
+	} else {
+		c.hist, c.err = s[0].HistogramAuto(BinsSturges)
+	}
+
+	return c
+}
+
+// Box creates a box plot of each of the columns cols. The whiskers extend
+// to the most extreme values within 1.5 times the interquartile range of
+// the box, and values beyond the whiskers are drawn as points. Missing
+// (NaN) and infinite values are skipped.
+func (p *Plotter) Box(cols ...string) *Chart {
+	c := p.newChart(boxChart, "", "")
+	if len(cols) == 0 {
+		c.err = errors.New("at least one column must be plotted")
+		return c
+	}
+
+	s, err := p.numeric(cols...)
+	if err != nil {
+		c.err = err
+		return c
+	}
+
+	for _, v := range s {
+		values := v.finite()
+		if len(values) == 0 {
+			c.err = fmt.Errorf("Series %s has no values", v.Name)
+			return c
+		}
+		sort.Float64s(values)
+
+		c.categories = append(c.categories, v.Name)
+		c.boxes = append(c.boxes, newBoxStats(values))
+	}
+
+	return c
+}
+
+// SaveSVG writes the chart as an SVG file at the provided path.
+func (c *Chart) SaveSVG(path string) error {
+	if c.err != nil {
+		return c.err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := c.WriteSVG(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// WriteSVG writes the chart as an SVG document to w.
+func (c *Chart) WriteSVG(w io.Writer) error {
+	if c.err != nil {
+		return c.err
+	}
+
+	b := bytes.Buffer{}
+	right := float64(c.Width - marginRight)
+	if c.hasLegend() {
+		right -= legendWidth
+	}
+
+	area := plotArea{
+		left:   marginLeft,
+		right:  right,
+		top:    marginTop,
+		bottom: float64(c.Height - marginBottom),
+	}
+
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n",
+		c.Width, c.Height, c.Width, c.Height)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="white"/>`+"\n", c.Width, c.Height)
+	if c.Title != "" {
+		fmt.Fprintf(&b, `<text x="%g" y="24" text-anchor="middle" font-size="16">%s</text>`+"\n",
+			float64(c.Width)/2, escapeXML(c.Title))
+	}
+
+	switch c.kind {
+	case scatterChart, lineChart:
+		c.writeXY(&b, area)
+	case histogramChart:
+		c.writeHistogram(&b, area)
+	case barChart, boxChart:
+		c.writeCategorical(&b, area)
+	}
+
+	if c.XLabel != "" {
+		fmt.Fprintf(&b, `<text x="%g" y="%d" text-anchor="middle">%s</text>`+"\n",
+			(area.left+area.right)/2, c.Height-12, escapeXML(c.XLabel))
+	}
+
+	if c.YLabel != "" {
+		fmt.Fprintf(&b, `<text x="16" y="%g" text-anchor="middle" transform="rotate(-90 16 %g)">%s</text>`+"\n",
+			(area.top+area.bottom)/2, (area.top+area.bottom)/2, escapeXML(c.YLabel))
+	}
+
+	if c.hasLegend() {
+		c.writeLegend(&b, area)
+	}
+
+	b.WriteString("</svg>\n")
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+func (p *Plotter) newChart(kind chartKind, x, y string) *Chart {
+	c := Chart{XLabel: x, YLabel: y, Width: 640, Height: 480, kind: kind}
+
+	switch kind {
+	case scatterChart, lineChart:
+		c.Title = fmt.Sprintf("%s vs %s", y, x)
+	case barChart, histogramChart:
+		c.Title = x
+	}
+
+	return &c
+}
+
+// numeric returns the named Series, checking that none are categorical.
+func (p *Plotter) numeric(n ...string) (DataFrame, error) {
+	s, err := p.d.seriesByName(n...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range s {
+		if v.IsCategorical() == true {
+			return nil, fmt.Errorf("Series %s is categorical", v.Name)
+		}
+	}
+
+	return s, nil
+}
+
+func (c *Chart) hasLegend() bool {
+	return (c.kind == scatterChart || c.kind == lineChart) && len(c.series) > 1
+}
+
+type plotArea struct {
+	left, right, top, bottom float64
+}
+
+// linearScale maps values in the domain d0 to d1 onto the range r0 to r1.
+type linearScale struct {
+	d0, d1, r0, r1 float64
+}
+
+func (s linearScale) at(v float64) float64 {
+	return s.r0 + (v-s.d0)*(s.r1-s.r0)/(s.d1-s.d0)
+}
+
+func (c *Chart) writeXY(b *bytes.Buffer, a plotArea) {
+	xs, ys := []float64{}, []float64{}
+	for _, s := range c.series {
+		xs = append(xs, s.x...)
+		ys = append(ys, s.y...)
+	}
+
+	xt := niceTicks(xs)
+	yt := niceTicks(ys)
+	x := linearScale{xt[0], xt[len(xt)-1], a.left, a.right}
+	y := linearScale{yt[0], yt[len(yt)-1], a.bottom, a.top}
+
+	writeXAxis(b, a, x, xt)
+	writeYAxis(b, a, y, yt)
+
+	for i, s := range c.series {
+		colour := palette[i%len(palette)]
+
+		if c.kind == lineChart {
+			points := []string{}
+			for j := range s.x {
+				if isFinite(s.x[j]) == false || isFinite(s.y[j]) == false {
+					continue
+				}
+				points = append(points, fmt.Sprintf("%.2f,%.2f", x.at(s.x[j]), y.at(s.y[j])))
+			}
+			fmt.Fprintf(b, `<polyline points="%s" fill="none" stroke="%s" stroke-width="2"/>`+"\n",
+				strings.Join(points, " "), colour)
+			continue
+		}
+
+		for j := range s.x {
+			if isFinite(s.x[j]) == false || isFinite(s.y[j]) == false {
+				continue
+			}
+			fmt.Fprintf(b, `<circle cx="%.2f" cy="%.2f" r="3" fill="%s" fill-opacity="0.7"/>`+"\n",
+				x.at(s.x[j]), y.at(s.y[j]), colour)
+		}
+	}
+}
+
+func (c *Chart) writeHistogram(b *bytes.Buffer, a plotArea) {
+	counts := []float64{0}
+	for _, v := range c.hist.Counts {
+		counts = append(counts, float64(v))
+	}
+
+	xt := niceTicks(c.hist.Edges)
+	yt := niceTicks(counts)
+	x := linearScale{xt[0], xt[len(xt)-1], a.left, a.right}
+	y := linearScale{yt[0], yt[len(yt)-1], a.bottom, a.top}
+
+	writeXAxis(b, a, x, xt)
+	writeYAxis(b, a, y, yt)
+
+	for i, v := range c.hist.Counts {
+		x0, x1 := x.at(c.hist.Edges[i]), x.at(c.hist.Edges[i+1])
+		fmt.Fprintf(b, `<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="%s" stroke="white"/>`+"\n",
+			x0, y.at(float64(v)), x1-x0, y.at(0)-y.at(float64(v)), palette[0])
+	}
+}
+
+func (c *Chart) writeCategorical(b *bytes.Buffer, a plotArea) {
+	values := []float64{}
+	if c.kind == barChart {
+		values = append(values, 0)
+		values = append(values, c.bars...)
+	}
+	for _, v := range c.boxes {
+		values = append(values, v.lo, v.hi)
+		values = append(values, v.outliers...)
+	}
+
+	yt := niceTicks(values)
+	y := linearScale{yt[0], yt[len(yt)-1], a.bottom, a.top}
+	writeYAxis(b, a, y, yt)
+
+	band := (a.right - a.left) / float64(len(c.categories))
+	fmt.Fprintf(b, `<line x1="%g" y1="%g" x2="%g" y2="%g" stroke="black"/>`+"\n", a.left, a.bottom, a.right, a.bottom)
+
+	for i, l := range c.categories {
+		mid := a.left + band*(float64(i)+0.5)
+		w := band * 0.6
+		fmt.Fprintf(b, `<text x="%.2f" y="%g" text-anchor="middle">%s</text>`+"\n", mid, a.bottom+18, escapeXML(l))
+
+		if c.kind == barChart {
+			fmt.Fprintf(b, `<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="%s"/>`+"\n",
+				mid-w/2, y.at(c.bars[i]), w, y.at(0)-y.at(c.bars[i]), palette[0])
+			continue
+		}
+
+		box := c.boxes[i]
+		colour := palette[i%len(palette)]
+		fmt.Fprintf(b, `<line x1="%.2f" y1="%.2f" x2="%.2f" y2="%.2f" stroke="black"/>`+"\n", mid, y.at(box.lo), mid, y.at(box.q1))
+		fmt.Fprintf(b, `<line x1="%.2f" y1="%.2f" x2="%.2f" y2="%.2f" stroke="black"/>`+"\n", mid, y.at(box.q3), mid, y.at(box.hi))
+		fmt.Fprintf(b, `<line x1="%.2f" y1="%.2f" x2="%.2f" y2="%.2f" stroke="black"/>`+"\n", mid-w/4, y.at(box.lo), mid+w/4, y.at(box.lo))
+		fmt.Fprintf(b, `<line x1="%.2f" y1="%.2f" x2="%.2f" y2="%.2f" stroke="black"/>`+"\n", mid-w/4, y.at(box.hi), mid+w/4, y.at(box.hi))
+		fmt.Fprintf(b, `<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="%s" fill-opacity="0.5" stroke="black"/>`+"\n",
+			mid-w/2, y.at(box.q3), w, y.at(box.q1)-y.at(box.q3), colour)
+		fmt.Fprintf(b, `<line x1="%.2f" y1="%.2f" x2="%.2f" y2="%.2f" stroke="black" stroke-width="2"/>`+"\n",
+			mid-w/2, y.at(box.median), mid+w/2, y.at(box.median))
+		for _, o := range box.outliers {
+			fmt.Fprintf(b, `<circle cx="%.2f" cy="%.2f" r="3" fill="none" stroke="black"/>`+"\n", mid, y.at(o))
+		}
+	}
+}
+
+func (c *Chart) writeLegend(b *bytes.Buffer, a plotArea) {
+	x := a.right + 15
+
+	for i, s := range c.series {
+		y := a.top + 10 + float64(i)*20
+		colour := palette[i%len(palette)]
+		fmt.Fprintf(b, `<rect x="%g" y="%g" width="12" height="12" fill="%s"/>`+"\n", x, y-10, colour)
+		fmt.Fprintf(b, `<text x="%g" y="%g">%s</text>`+"\n", x+18, y, escapeXML(s.name))
+	}
+}
+
+func writeXAxis(b *bytes.Buffer, a plotArea, x linearScale, ticks []float64) {
+	fmt.Fprintf(b, `<line x1="%g" y1="%g" x2="%g" y2="%g" stroke="black"/>`+"\n", a.left, a.bottom, a.right, a.bottom)
+
+	for _, t := range ticks {
+		p := x.at(t)
+		fmt.Fprintf(b, `<line x1="%.2f" y1="%g" x2="%.2f" y2="%g" stroke="black"/>`+"\n", p, a.bottom, p, a.bottom+5)
+		fmt.Fprintf(b, `<text x="%.2f" y="%g" text-anchor="middle">%s</text>`+"\n", p, a.bottom+18, formatEdge(t))
+	}
+}
+
+func writeYAxis(b *bytes.Buffer, a plotArea, y linearScale, ticks []float64) {
+	fmt.Fprintf(b, `<line x1="%g" y1="%g" x2="%g" y2="%g" stroke="black"/>`+"\n", a.left, a.top, a.left, a.bottom)
+
+	for _, t := range ticks {
+		p := y.at(t)
+		fmt.Fprintf(b, `<line x1="%g" y1="%.2f" x2="%g" y2="%.2f" stroke="#dddddd"/>`+"\n", a.left, p, a.right, p)
+		fmt.Fprintf(b, `<text x="%g" y="%.2f" text-anchor="end" dominant-baseline="middle">%s</text>`+"\n", a.left-6, p, formatEdge(t))
+	}
+}
+
+// niceTicks returns around 5 evenly spaced axis ticks at round numbers,
+// covering all the values that are not missing or infinite. At least
+// 2 ticks are always returned.
+func niceTicks(v []float64) []float64 {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, x := range v {
+		if isFinite(x) == true {
+			lo = math.Min(lo, x)
+			hi = math.Max(hi, x)
+		}
+	}
+
+	if math.IsInf(lo, 0) {
+		lo, hi = 0, 1
+	}
+
+	if lo == hi {
+		lo, hi = lo-1, hi+1
+	}
+
+	step := niceNumber((hi - lo) / 4)
+	start := math.Floor(lo/step) * step
+	end := math.Ceil(hi/step) * step
+
+	t := []float64{}
+	for i := 0; start+float64(i)*step <= end+step/2; i++ {
+		t = append(t, start+float64(i)*step)
+	}
+
+	if len(t) < 2 {
+		return []float64{lo, hi}
+	}
+
+	return t
+}
+
+func isFinite(v float64) bool {
+	return isNA(v) == false && math.IsInf(v, 0) == false
+}
+
+// niceNumber rounds v to a number that is 1, 2 or 5 times a power of 10.
+func niceNumber(v float64) float64 {
+	e := math.Floor(math.Log10(v))
+	f := v / math.Pow(10, e)
+
+	var n float64
+	switch {
+	case f < 1.5:
+		n = 1
+	case f < 3:
+		n = 2
+	case f < 7:
+		n = 5
+	default:
+		n = 10
+	}
+
+	return n * math.Pow(10, e)
+}
+
+var xmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;", "'", "&apos;")
+
+func escapeXML(s string) string {
+	return xmlEscaper.Replace(s)
+}
@@ -0,0 +1,154 @@
+package gander
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countElements parses an SVG document, returning the number
+// of elements with each name.
+func countElements(t *testing.T, svg string) map[string]int {
+	c := map[string]int{}
+	d := xml.NewDecoder(strings.NewReader(svg))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		assert.Equal(t, nil, err, "svg is not valid xml")
+		if err != nil {
+			break
+		}
+		if e, ok := tok.(xml.StartElement); ok {
+			c[e.Name.Local]++
+		}
+	}
+	return c
+}
+
+func writeChart(t *testing.T, c *Chart) string {
+	b := bytes.Buffer{}
+	err := c.WriteSVG(&b)
+	assert.Equal(t, nil, err, "error is not nil")
+	return b.String()
+}
+
+func TestPlotScatter(t *testing.T) {
+	df, _ := LoadCSVFromPath("./testdata/MOCK_DATA.csv")
+	svg := writeChart(t, df.Plot().Scatter("height cms", "weight kgs"))
+	c := countElements(t, svg)
+	assert.Equal(t, 1000, c["circle"], "wrong number of points")
+	assert.Equal(t, true, strings.Contains(svg, ">weight kgs vs height cms</text>"), "title is missing")
+	assert.Equal(t, 0, strings.Count(svg, "NaN"), "points are not scaled correctly")
+}
+
+func TestPlotScatterByHasLegend(t *testing.T) {
+	df, _ := LoadCSVFromPath("./testdata/MOCK_DATA.csv")
+	svg := writeChart(t, df.Plot().ScatterBy("height cms", "weight kgs", "sex"))
+	assert.Equal(t, 1000, countElements(t, svg)["circle"], "wrong number of points")
+	assert.Equal(t, true, strings.Contains(svg, ">F</text>"), "legend is missing")
+	assert.Equal(t, true, strings.Contains(svg, ">M</text>"), "legend is missing")
+}
+
+func TestPlotLine(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+	svg := writeChart(t, df.Plot().Line("a", "b", "c"))
+	assert.Equal(t, 2, countElements(t, svg)["polyline"], "wrong number of lines")
+}
+
+func TestPlotBar(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithCategoricalData())
+	c := df.Plot().Bar("d")
+	c.Title = "Counts & <labels>"
+	svg := writeChart(t, c)
+	// one rect for the background and one for each bar
+	assert.Equal(t, 3, countElements(t, svg)["rect"], "wrong number of bars")
+	assert.Equal(t, true, strings.Contains(svg, "Counts &amp; &lt;labels&gt;"), "title is not escaped")
+}
+
+func TestPlotHistogram(t *testing.T) {
+	df, _ := LoadCSVFromPath("./testdata/MOCK_DATA.csv")
+	svg := writeChart(t, df.Plot().Histogram("salary", 8))
+	assert.Equal(t, 9, countElements(t, svg)["rect"], "wrong number of bars")
+}
+
+func TestPlotBox(t *testing.T) {
+	df := DataFrame{createOutlierTestSeries(), createTestSeries()}
+	svg := writeChart(t, df.Plot().Box("x", "MySeries"))
+	c := countElements(t, svg)
+	assert.Equal(t, 3, c["rect"], "wrong number of boxes")
+	assert.Equal(t, 1, c["circle"], "wrong number of outliers")
+}
+
+func TestPlotHistogramInfiniteValues(t *testing.T) {
+	df := DataFrame{NewSeries("x", []float64{0, 1, math.Inf(1), 2, math.Inf(-1), 3, math.NaN(), 4})}
+	c := df.Plot().Histogram("x", 4)
+	svg := writeChart(t, c)
+	assert.Equal(t, []float64{0, 1, 2, 3, 4}, c.hist.Edges, "edges should cover the finite values")
+	assert.Equal(t, []int{2, 1, 1, 1}, c.hist.Counts, "infinite values should not be counted")
+	assert.Equal(t, 0, strings.Count(svg, "NaN")+strings.Count(svg, "Inf"), "bars are not scaled correctly")
+
+	df = DataFrame{NewSeries("x", []float64{math.Inf(1), math.NaN()})}
+	err := df.Plot().Histogram("x", 0).WriteSVG(&bytes.Buffer{})
+	assert.Equal(t, "Series x has no values", err.Error(), "error is not correct")
+}
+
+func TestPlotBoxInfiniteValues(t *testing.T) {
+	s := NewSeries("x", []float64{0, 2, 7, math.Inf(1), 1, 4, math.Inf(-1), 1, 3, 7, 3, 4})
+	df := DataFrame{s}
+	svg := writeChart(t, df.Plot().Box("x"))
+	c := countElements(t, svg)
+	assert.Equal(t, 2, c["rect"], "wrong number of boxes")
+	assert.Equal(t, 0, c["circle"], "infinite values should not be drawn as outliers")
+	assert.Equal(t, 0, strings.Count(svg, "NaN")+strings.Count(svg, "Inf"), "box is not scaled correctly")
+
+	df = DataFrame{NewSeries("x", []float64{math.Inf(1)})}
+	err := df.Plot().Box("x").WriteSVG(&bytes.Buffer{})
+	assert.Equal(t, "Series x has no values", err.Error(), "error is not correct")
+}
+
+func TestPlotErrors(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithCategoricalData())
+	err := df.Plot().Scatter("a", "d").WriteSVG(&bytes.Buffer{})
+	assert.Equal(t, "Series d is categorical", err.Error(), "")
+	err = df.Plot().Bar("a").WriteSVG(&bytes.Buffer{})
+	assert.Equal(t, "Series a is not categorical", err.Error(), "")
+	err = df.Plot().Line("a", "z").SaveSVG("unused.svg")
+	assert.Equal(t, "column 'z' does not exist in the DataFrame", err.Error(), "")
+}
+
+func TestPlotSaveSVG(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+	path := filepath.Join(t.TempDir(), "chart.svg")
+	err := df.Plot().Scatter("a", "b").SaveSVG(path)
+	assert.Equal(t, nil, err, "error is not nil")
+	b, err := os.ReadFile(path)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 4, countElements(t, string(b))["circle"], "wrong number of points")
+}
+
+func TestNiceTicks(t *testing.T) {
+	assert.Equal(t, []float64{0, 2, 4, 6, 8}, niceTicks([]float64{0, 2, 7, 1, 4}), "ticks are not correct")
+	assert.Equal(t, []float64{40, 60, 80, 100, 120}, niceTicks([]float64{41, 119}), "ticks are not correct")
+	assert.Equal(t, []float64{2, 2.5, 3, 3.5, 4}, niceTicks([]float64{3}), "ticks are not correct")
+	assert.Equal(t, []float64{0, 0.5, 1, 1.5, 2}, niceTicks([]float64{1, math.Inf(1), math.Inf(-1)}), "infinite values are not skipped")
+	assert.Equal(t, []float64{-1e308, 1e308}, niceTicks([]float64{-1e308, 1e308}), "ticks should cover the values")
+}
+
+func TestPlotScatterInfiniteValues(t *testing.T) {
+	df := DataFrame{
+		NewSeries("x", []float64{1, math.Inf(1), 2}),
+		NewSeries("y", []float64{1, 2, 3}),
+	}
+	svg := writeChart(t, df.Plot().Scatter("x", "y"))
+	assert.Equal(t, 2, countElements(t, svg)["circle"], "wrong number of points")
+	assert.Equal(t, 0, strings.Count(svg, "Inf"), "infinite values are not skipped")
+}
@@ -0,0 +1,122 @@
+package gander
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Sample returns a new DataFrame containing n randomly selected rows.
+// If replace is true, rows are selected with replacement, so the same
+// row may appear more than once. The same seed always selects the same
+// rows from the same DataFrame.
+func (d *DataFrame) Sample(n int, replace bool, seed int64) (*DataFrame, error) {
+	if n < 0 {
+		return nil, errors.New("number of rows to sample cannot be negative")
+	}
+
+	rows := d.rowCount()
+
+	if n > 0 && rows == 0 {
+		return nil, errors.New("cannot sample rows from an empty DataFrame")
+	}
+
+	if replace == false && n > rows {
+		return nil, errors.New("cannot sample more rows than the DataFrame contains without replacement")
+	}
+
+	rnd := rand.New(rand.NewSource(seed))
+	r := []int{}
+
+	if replace == true {
+		for i := 0; i < n; i++ {
+			r = append(r, rnd.Intn(rows))
+		}
+	} else {
+		r = rnd.Perm(rows)[:n]
+	}
+
+	return d.takeRows(r), nil
+}
+
+// SampleFrac returns a new DataFrame containing the fraction frac of the
+// rows, selected at random. It behaves in the same way as Sample.
+func (d *DataFrame) SampleFrac(frac float64, replace bool, seed int64) (*DataFrame, error) {
+	if frac < 0 {
+		return nil, errors.New("fraction of rows to sample cannot be negative")
+	}
+
+	return d.Sample(int(math.Floor(frac*float64(d.rowCount())+0.5)), replace, seed)
+}
+
+// Shuffle randomly reorders the rows of the DataFrame in place.
+// The same seed always produces the same order.
+func (d *DataFrame) Shuffle(seed int64) {
+	rnd := rand.New(rand.NewSource(seed))
+	*d = *d.takeRows(rnd.Perm(d.rowCount()))
+}
+
+// TrainTestSplit randomly divides the rows of the DataFrame into two new
+// DataFrames, with the fraction frac of the rows in the first (training)
+// DataFrame and the remainder in the second (test) DataFrame. If stratifyBy
+// names a column, typically a categorical one, each distinct value of that
+// column is split separately so that both DataFrames keep the same class
+// proportions, with all the missing (NaN) values of the column split as a
+// single class. Rows keep their original order in both DataFrames.
+func (d *DataFrame) TrainTestSplit(frac float64, seed int64, stratifyBy string) (*DataFrame, *DataFrame, error) {
+	if frac < 0 || frac > 1 {
+		return nil, nil, errors.New("training fraction must be between 0 and 1")
+	}
+
+	groups := [][]int{}
+
+	if stratifyBy == "" {
+		g := []int{}
+		for i := 0; i < d.rowCount(); i++ {
+			g = append(g, i)
+		}
+		groups = append(groups, g)
+	} else {
+		s, err := d.seriesByName(stratifyBy)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		index := map[float64]int{}
+		na := -1
+		for i, v := range s[0].Values {
+			if isNA(v) == true {
+				if na < 0 {
+					na = len(groups)
+					groups = append(groups, []int{})
+				}
+				groups[na] = append(groups[na], i)
+				continue
+			}
+			if _, ok := index[v]; ok == false {
+				index[v] = len(groups)
+				groups = append(groups, []int{})
+			}
+			groups[index[v]] = append(groups[index[v]], i)
+		}
+	}
+
+	rnd := rand.New(rand.NewSource(seed))
+	train := []int{}
+	test := []int{}
+
+	for _, g := range groups {
+		rnd.Shuffle(len(g), func(i, j int) {
+			g[i], g[j] = g[j], g[i]
+		})
+		n := int(math.Floor(frac*float64(len(g)) + 0.5))
+		train = append(train, g[:n]...)
+		test = append(test, g[n:]...)
+	}
+
+	sort.Ints(train)
+	sort.Ints(test)
+
+	return d.takeRows(train), d.takeRows(test), nil
+}
@@ -0,0 +1,130 @@
+package gander
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampleWithoutReplacement(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithCategoricalData())
+	s, err := df.Sample(3, false, 1)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 3, s.Rows(), "wrong number of rows sampled")
+	assert.Equal(t, 4, df.Rows(), "original dataframe was changed")
+	assert.Equal(t, 3, (*s)[0].NUnique(), "a row was sampled more than once")
+	assert.Equal(t, true, (*s)[3].IsCategorical(), "categorical data was lost")
+
+	for i := 0; i < s.Rows(); i++ {
+		// column a uniquely identifies each row of the sample data
+		for j := 0; j < df.Rows(); j++ {
+			if (*df)[0].Values[j] == (*s)[0].Values[i] {
+				assert.Equal(t, df.toRow(j), s.toRow(i), "rows are not aligned")
+			}
+		}
+	}
+}
+
+func TestSampleTooManyRows(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+	_, err := df.Sample(5, false, 1)
+	assert.Equal(t, "cannot sample more rows than the DataFrame contains without replacement", err.Error(), "")
+	s, err := df.Sample(10, true, 1)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 10, s.Rows(), "wrong number of rows sampled")
+}
+
+func TestSampleEmptyDataFrame(t *testing.T) {
+	df := DataFrame{NewSeries("a", []float64{})}
+	_, err := df.Sample(1, true, 1)
+	assert.Equal(t, "cannot sample rows from an empty DataFrame", err.Error(), "error is not correct")
+	s, err := df.Sample(0, true, 1)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 0, s.Rows(), "wrong number of rows sampled")
+}
+
+func TestSampleIsReproducible(t *testing.T) {
+	df, _ := LoadCSVFromPath("./testdata/MOCK_DATA.csv")
+	a, _ := df.SampleFrac(0.1, false, 42)
+	b, _ := df.SampleFrac(0.1, false, 42)
+	assert.Equal(t, 100, a.Rows(), "wrong number of rows sampled")
+	assert.Equal(t, (*a)[0].Values, (*b)[0].Values, "samples with the same seed are different")
+}
+
+func TestShuffle(t *testing.T) {
+	df, _ := LoadCSVFromPath("./testdata/MOCK_DATA.csv")
+	e, _ := LoadCSVFromPath("./testdata/MOCK_DATA.csv")
+	df.Shuffle(7)
+	assert.Equal(t, 1000, df.Rows(), "rows were lost")
+	assert.NotEqual(t, (*e)[0].Values, (*df)[0].Values, "rows were not shuffled")
+
+	// the id column is 1 based
+	for i := 0; i < df.Rows(); i++ {
+		assert.Equal(t, e.toRow(int((*df)[0].Values[i])-1), df.toRow(i), "rows are not aligned")
+	}
+}
+
+func TestTrainTestSplit(t *testing.T) {
+	df, _ := LoadCSVFromPath("./testdata/MOCK_DATA.csv")
+	train, test, err := df.TrainTestSplit(0.8, 3, "")
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 800, train.Rows(), "wrong number of training rows")
+	assert.Equal(t, 200, test.Rows(), "wrong number of test rows")
+
+	all := append(append([]float64{}, (*train)[0].Values...), (*test)[0].Values...)
+	assert.Equal(t, 1000, NewSeries("id", all).NUnique(), "rows are in both splits")
+}
+
+func TestTrainTestSplitStratified(t *testing.T) {
+	df, _ := LoadCSVFromPath("./testdata/MOCK_DATA.csv")
+	train, test, err := df.TrainTestSplit(0.75, 3, "sex")
+	assert.Equal(t, nil, err, "error is not nil")
+
+	proportions := func(d *DataFrame) map[string]float64 {
+		s, _ := d.seriesByName("sex")
+		m := map[string]float64{}
+		for _, c := range s[0].ValueCounts(true, false) {
+			m[c.Label] = c.Proportion
+		}
+		return m
+	}
+
+	e := proportions(df)
+	for _, d := range []*DataFrame{train, test} {
+		for k, v := range proportions(d) {
+			assert.InDelta(t, e[k], v, 0.005, "class proportions were not kept")
+		}
+	}
+}
+
+func TestTrainTestSplitStratifiedMissingValues(t *testing.T) {
+	df := DataFrame{
+		NewSeries("id", []float64{1, 2, 3, 4, 5, 6, 7, 8}),
+		NewSeries("g", []float64{1, na, 1, na, 1, na, 1, na}),
+	}
+	train, test, err := df.TrainTestSplit(0.5, 1, "g")
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 4, train.Rows(), "wrong number of training rows")
+	assert.Equal(t, 4, test.Rows(), "wrong number of test rows")
+	assert.Equal(t, 2, len((*train)[1].valid()), "missing values were not split as a single class")
+}
+
+func TestSampleWithNoColumns(t *testing.T) {
+	df := DataFrame{}
+	s, err := df.SampleFrac(0.5, false, 1)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 0, s.Columns(), "wrong number of columns")
+
+	df.Shuffle(1)
+	assert.Equal(t, 0, df.Columns(), "wrong number of columns")
+
+	train, test, err := df.TrainTestSplit(0.5, 1, "")
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 0, train.Columns()+test.Columns(), "wrong number of columns")
+}
+
+func TestTrainTestSplitInvalidColumn(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+	_, _, err := df.TrainTestSplit(0.5, 1, "f")
+	assert.Equal(t, "column 'f' does not exist in the DataFrame", err.Error(), "")
+}
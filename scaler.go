@@ -0,0 +1,204 @@
+package gander
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// A Scaler rescales the non-categorical Series in a DataFrame. The
+// parameters used for scaling are learnt by calling Fit, and can then
+// be applied to any DataFrame with the same columns, for example to
+// scale a test set using the values from a training set.
+type Scaler interface {
+	Fit(d *DataFrame) error
+	Transform(d *DataFrame) error
+	InverseTransform(d *DataFrame) error
+}
+
+// A ColumnScale holds the fitted parameters for a single column.
+// A value x is scaled as (x - Center) / Scale. If a column has no
+// spread, for example when every value is the same, Scale is set to 1
+// so that the column is only centred, rather than being divided by zero.
+type ColumnScale struct {
+	Column string
+	Center float64
+	Scale  float64
+}
+
+// A StandardScaler scales values to have a mean of 0 and a standard
+// deviation of 1.
+type StandardScaler struct {
+	Columns []ColumnScale
+}
+
+// Fit finds the mean and standard deviation of each non-categorical Series.
+func (s *StandardScaler) Fit(d *DataFrame) error {
+	c, err := fitColumns(d, func(v *Series) (float64, float64) {
+		return v.Mean(), v.StdDev()
+	})
+	s.Columns = c
+	return err
+}
+
+// Transform scales the fitted columns of the DataFrame in place.
+func (s *StandardScaler) Transform(d *DataFrame) error {
+	return transformColumns(d, s.Columns)
+}
+
+// InverseTransform returns the fitted columns of the DataFrame to their
+// original scale.
+func (s *StandardScaler) InverseTransform(d *DataFrame) error {
+	return inverseTransformColumns(d, s.Columns)
+}
+
+// A MinMaxScaler scales values to lie between 0 and 1.
+type MinMaxScaler struct {
+	Columns []ColumnScale
+}
+
+// Fit finds the minimum and maximum of each non-categorical Series.
+func (s *MinMaxScaler) Fit(d *DataFrame) error {
+	c, err := fitColumns(d, func(v *Series) (float64, float64) {
+		min, max := v.Range()
+		return min, max - min
+	})
+	s.Columns = c
+	return err
+}
+
+// Transform scales the fitted columns of the DataFrame in place.
+func (s *MinMaxScaler) Transform(d *DataFrame) error {
+	return transformColumns(d, s.Columns)
+}
+
+// InverseTransform returns the fitted columns of the DataFrame to their
+// original scale.
+func (s *MinMaxScaler) InverseTransform(d *DataFrame) error {
+	return inverseTransformColumns(d, s.Columns)
+}
+
+// A RobustScaler centres values on the median and scales them by the
+// interquartile range, so that it is not affected by outliers.
+type RobustScaler struct {
+	Columns []ColumnScale
+}
+
+// Fit finds the median and interquartile range of each non-categorical Series.
+func (s *RobustScaler) Fit(d *DataFrame) error {
+	c, err := fitColumns(d, func(v *Series) (float64, float64) {
+		return v.Median(), v.Quantile(0.75) - v.Quantile(0.25)
+	})
+	s.Columns = c
+	return err
+}
+
+// Transform scales the fitted columns of the DataFrame in place.
+func (s *RobustScaler) Transform(d *DataFrame) error {
+	return transformColumns(d, s.Columns)
+}
+
+// InverseTransform returns the fitted columns of the DataFrame to their
+// original scale.
+func (s *RobustScaler) InverseTransform(d *DataFrame) error {
+	return inverseTransformColumns(d, s.Columns)
+}
+
+// A MaxAbsScaler scales values by their maximum absolute value, so that
+// they lie between -1 and 1. Values are not centred.
+type MaxAbsScaler struct {
+	Columns []ColumnScale
+}
+
+// Fit finds the maximum absolute value of each non-categorical Series.
+func (s *MaxAbsScaler) Fit(d *DataFrame) error {
+	c, err := fitColumns(d, func(v *Series) (float64, float64) {
+		min, max := v.Range()
+		return 0, math.Max(math.Abs(min), math.Abs(max))
+	})
+	s.Columns = c
+	return err
+}
+
+// Transform scales the fitted columns of the DataFrame in place.
+func (s *MaxAbsScaler) Transform(d *DataFrame) error {
+	return transformColumns(d, s.Columns)
+}
+
+// InverseTransform returns the fitted columns of the DataFrame to their
+// original scale.
+func (s *MaxAbsScaler) InverseTransform(d *DataFrame) error {
+	return inverseTransformColumns(d, s.Columns)
+}
+
+// fitColumns finds the parameters of each non-categorical Series, using
+// only the values that are not missing. Missing values are left as NaN
+// when the columns are transformed.
+func fitColumns(d *DataFrame, fn func(*Series) (float64, float64)) ([]ColumnScale, error) {
+	if d.Columns() == 0 || d.Rows() == 0 {
+		return nil, errors.New("cannot fit a scaler to an empty DataFrame")
+	}
+
+	r := make([]*ColumnScale, d.Columns())
+	parallelFor(d.Columns(), func(i int) {
+		v := (*d)[i]
+		if v.IsCategorical() == false {
+			center, scale := 0.0, 1.0
+			if valid := NewSeries(v.Name, v.valid()); len(valid.Values) > 0 {
+				center, scale = fn(valid)
+			}
+			if scale == 0 || math.IsNaN(scale) {
+				scale = 1
+			}
+			r[i] = &ColumnScale{Column: v.Name, Center: center, Scale: scale}
+		}
+	})
+
+	c := []ColumnScale{}
+	for _, v := range r {
+		if v != nil {
+			c = append(c, *v)
+		}
+	}
+
+	return c, nil
+}
+
+func transformColumns(d *DataFrame, c []ColumnScale) error {
+	return scaleColumns(d, c, func(x float64, p ColumnScale) float64 {
+		return (x - p.Center) / p.Scale
+	})
+}
+
+func inverseTransformColumns(d *DataFrame, c []ColumnScale) error {
+	return scaleColumns(d, c, func(x float64, p ColumnScale) float64 {
+		return x*p.Scale + p.Center
+	})
+}
+
+func scaleColumns(d *DataFrame, c []ColumnScale, fn func(float64, ColumnScale) float64) error {
+	if c == nil {
+		return errors.New("scaler has not been fitted")
+	}
+
+	// check all the columns before changing any values
+	for _, p := range c {
+		s, err := d.seriesByName(p.Column)
+		if err != nil {
+			return err
+		}
+		if s[0].IsCategorical() == true {
+			return fmt.Errorf("Series %s is categorical", p.Column)
+		}
+	}
+
+	parallelFor(len(c), func(i int) {
+		p := c[i]
+		s, _ := d.seriesByName(p.Column)
+		s[0].Transform(func(x float64) float64 {
+			return fn(x, p)
+		})
+	})
+
+	return nil
+}
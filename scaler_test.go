@@ -0,0 +1,121 @@
+package gander
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStandardScalerFitTransform(t *testing.T) {
+	df, err := NewDataFrame(createSampleDataWithCategoricalData())
+	assert.Equal(t, nil, err, "error is not nil")
+	s := StandardScaler{}
+	assert.Equal(t, nil, s.Fit(df), "error is not nil")
+	assert.Equal(t, 4, len(s.Columns), "categorical column was fitted")
+	assert.Equal(t, nil, s.Transform(df), "error is not nil")
+
+	for _, v := range *df {
+		if v.IsCategorical() == false {
+			assert.Equal(t, true, toleratedError(0.0, v.Mean()), "mean is not zero(ish)")
+			assert.Equal(t, true, toleratedError(1.0, v.StdDev()), "std dev is not one")
+		}
+	}
+}
+
+func TestScalerTransformUsesFittedParameters(t *testing.T) {
+	train, _ := NewDataFrame(createSampleDataWithHeaders())
+	test, _ := NewDataFrame([][]string{
+		{"a", "b", "c", "d", "e"},
+		{"7", "2", "3", "4", "5"},
+	})
+	s := MinMaxScaler{}
+	s.Fit(train)
+	s.Transform(test)
+	assert.Equal(t, 1.0, (*test)[0].Values[0], "training parameters were not used")
+	assert.Equal(t, 0.0, (*test)[1].Values[0], "training parameters were not used")
+}
+
+func TestScalerInverseTransform(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+	e, _ := NewDataFrame(createSampleDataWithHeaders())
+
+	for _, s := range []Scaler{&StandardScaler{}, &MinMaxScaler{}, &RobustScaler{}, &MaxAbsScaler{}} {
+		s.Fit(df)
+		s.Transform(df)
+		s.InverseTransform(df)
+
+		for c, v := range *df {
+			for i, x := range v.Values {
+				assert.Equal(t, true, toleratedError((*e)[c].Values[i], x), "values were not restored")
+			}
+		}
+	}
+}
+
+func TestScalerZeroVariance(t *testing.T) {
+	df, _ := NewDataFrame([][]string{
+		{"a", "b"},
+		{"3", "1"},
+		{"3", "2"},
+	})
+	s := StandardScaler{}
+	s.Fit(df)
+	s.Transform(df)
+	assert.Equal(t, 1.0, s.Columns[0].Scale, "zero variance scale is not one")
+	assert.Equal(t, []float64{0, 0}, (*df)[0].Values, "zero variance column is not centred")
+}
+
+func TestRobustScalerParameters(t *testing.T) {
+	df := &DataFrame{createTestSeries()}
+	s := RobustScaler{}
+	s.Fit(df)
+	assert.Equal(t, ColumnScale{Column: "MySeries", Center: 3, Scale: 2.75}, s.Columns[0], "parameters are not correct")
+}
+
+func TestScalerMissingValues(t *testing.T) {
+	df := DataFrame{NewSeries("a", []float64{1, math.NaN(), 3})}
+	s := StandardScaler{}
+	assert.Equal(t, nil, s.Fit(&df), "error is not nil")
+	assert.Equal(t, 2.0, s.Columns[0].Center, "mean should ignore missing values")
+	assert.Equal(t, 1.0, s.Columns[0].Scale, "std dev should ignore missing values")
+
+	assert.Equal(t, nil, s.Transform(&df), "error is not nil")
+	assert.Equal(t, -1.0, df[0].Values[0], "value is not scaled")
+	assert.Equal(t, true, math.IsNaN(df[0].Values[1]), "missing value should stay missing")
+	assert.Equal(t, 1.0, df[0].Values[2], "value is not scaled")
+
+	r := RobustScaler{}
+	assert.Equal(t, nil, r.Fit(&DataFrame{NewSeries("a", []float64{math.NaN(), 1, 2, 3})}), "error is not nil")
+	assert.Equal(t, 2.0, r.Columns[0].Center, "median should ignore missing values")
+}
+
+func TestScalerTransformMissingColumn(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+	s := MaxAbsScaler{}
+	s.Fit(df)
+	df.DropColumnsByName("c")
+	err := s.Transform(df)
+	assert.Equal(t, "column 'c' does not exist in the DataFrame", err.Error(), "")
+}
+
+func TestScalerNotFitted(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+	s := StandardScaler{}
+	err := s.Transform(df)
+	assert.Equal(t, "scaler has not been fitted", err.Error(), "")
+}
+
+func TestScalerJSONRoundTrip(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+	s := StandardScaler{}
+	s.Fit(df)
+	b, err := json.Marshal(s)
+	assert.Equal(t, nil, err, "error is not nil")
+
+	r := StandardScaler{}
+	err = json.Unmarshal(b, &r)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, s, r, "scaler was not restored from json")
+}
@@ -0,0 +1,291 @@
+package gander
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+)
+
+// Kind is the kind of data held by a Series.
+type Kind int
+
+const (
+	// KindNumeric is a Series of numbers.
+	KindNumeric Kind = iota
+	// KindCategorical is a Series of categorical labels.
+	KindCategorical
+)
+
+// String returns the name of the kind.
+func (k Kind) String() string {
+	if k == KindCategorical {
+		return "categorical"
+	}
+
+	return "numeric"
+}
+
+// A ColumnSchema declares the name and kind of a column, and the
+// rules its values must follow.
+type ColumnSchema struct {
+	Name string
+	Kind Kind
+	// Nullable allows missing (NaN) values.
+	Nullable bool
+	// Categories, if not empty, lists the allowed labels
+	// of a categorical column.
+	Categories []string
+	// Min and Max, if not nil, are the lowest and highest
+	// allowed values of a numeric column.
+	Min *float64
+	Max *float64
+	// Pattern, if not nil, must match every label of a categorical
+	// column, or every value of a numeric column formatted as text.
+	Pattern *regexp.Regexp
+	// Unique requires every value that is not missing to be different.
+	Unique bool
+}
+
+// A Schema declares the columns of a DataFrame. Loaders that read text,
+// such as LoadCSVFromPathWithSchema, LoadCSVFromZipWithSchema and those
+// taking URLOptions or XLSXOptions, use a schema to convert the columns
+// as they are loaded. Loaders that read typed data, such as LoadParquet
+// and LoadSQL, do not take a schema, but their results can be checked
+// with Validate.
+type Schema []ColumnSchema
+
+// Rule identifies the schema rule broken by a Violation.
+type Rule string
+
+const (
+	// RuleMissingColumn is broken by a column that does not exist.
+	RuleMissingColumn Rule = "missing column"
+	// RuleKind is broken by a column of the wrong kind, or by a value
+	// that cannot be converted to the declared kind.
+	RuleKind Rule = "kind"
+	// RuleNullable is broken by a missing value.
+	RuleNullable Rule = "nullable"
+	// RuleCategory is broken by a label that is not an allowed category.
+	RuleCategory Rule = "category"
+	// RuleMin is broken by a value below the minimum.
+	RuleMin Rule = "min"
+	// RuleMax is broken by a value above the maximum.
+	RuleMax Rule = "max"
+	// RulePattern is broken by a value that does not match the pattern.
+	RulePattern Rule = "pattern"
+	// RuleUnique is broken by a repeated value.
+	RuleUnique Rule = "unique"
+)
+
+// A Violation describes a value, or a whole column, that breaks a rule
+// of a Schema. Row is the zero based row number, or -1 if the violation
+// applies to the whole column.
+type Violation struct {
+	Row    int
+	Column string
+	Value  string
+	Rule   Rule
+}
+
+// String describes the violation.
+func (v Violation) String() string {
+	if v.Row < 0 {
+		return fmt.Sprintf("column '%s': breaks the %s rule", v.Column, v.Rule)
+	}
+
+	return fmt.Sprintf("row %d, column '%s': value '%s' breaks the %s rule", v.Row, v.Column, v.Value, v.Rule)
+}
+
+// A ValidationReport lists every violation of a Schema.
+// It is returned as an error by loaders that coerce values
+// using a Schema.
+type ValidationReport struct {
+	Violations []Violation
+}
+
+// Valid returns true if there are no violations.
+func (r *ValidationReport) Valid() bool {
+	return len(r.Violations) == 0
+}
+
+// Error summarises the report, giving the first violation.
+func (r *ValidationReport) Error() string {
+	switch len(r.Violations) {
+	case 0:
+		return "no schema violations"
+	case 1:
+		return r.Violations[0].String()
+	}
+
+	return fmt.Sprintf("%d schema violations, the first is %s", len(r.Violations), r.Violations[0])
+}
+
+// NewDataFrameWithSchema creates a DataFrame from a 2 dimensional string
+// slice, as NewDataFrame does, but the first row always holds the headers
+// and the columns named in the schema are converted to the declared kind.
+// Empty values become missing (NaN) values. Columns that are not in the
+// schema have their kind chosen as NewDataFrame does. If a column in the
+// schema is missing, or a value cannot be converted to a number, a
+// *ValidationReport is returned as the error.
+func NewDataFrameWithSchema(data [][]string, schema Schema) (*DataFrame, error) {
+	if !columnCountsMatch(data) {
+		return nil, errors.New("not all rows have the same number of columns")
+	}
+
+	headers := data[0]
+	rows := data[1:]
+	r := ValidationReport{}
+
+	for _, c := range schema {
+		if containsString(c.Name, headers) == false {
+			r.Violations = append(r.Violations, Violation{Row: -1, Column: c.Name, Rule: RuleMissingColumn})
+		}
+	}
+
+	d := make(DataFrame, len(headers))
+	violations := make([][]Violation, len(headers))
+	parallelFor(len(headers), func(x int) {
+		d[x], violations[x] = coerceSeries(headers[x], schema, rows, x)
+	})
+
+	for _, v := range violations {
+		r.Violations = append(r.Violations, v...)
+	}
+
+	if r.Valid() == false {
+		return nil, &r
+	}
+
+	return &d, nil
+}
+
+// coerceSeries converts a column of data to a Series of the kind
+// declared in the schema, returning the values that cannot be
+// converted.
+func coerceSeries(name string, schema Schema, rows [][]string, x int) (*Series, []Violation) {
+	c, ok := schema.column(name)
+	if ok == false {
+		if len(rows) == 0 {
+			return NewSeries(name, []float64{}), nil
+		}
+		return createSeries(name, rows, x), nil
+	}
+
+	if c.Kind == KindCategorical {
+		s := NewCategoricalSeries(name, []string{})
+		for _, v := range rows {
+			if v[x] == "" {
+				s.Values = append(s.Values, math.NaN())
+			} else {
+				s.appendLabel(v[x])
+			}
+		}
+		return s, nil
+	}
+
+	r := []Violation{}
+	values := []float64{}
+	for i, v := range rows {
+		f, err := strconv.ParseFloat(v[x], 64)
+		if v[x] == "" {
+			f = math.NaN()
+		} else if err != nil {
+			r = append(r, Violation{Row: i, Column: name, Value: v[x], Rule: RuleKind})
+		}
+		values = append(values, f)
+	}
+
+	return NewSeries(name, values), r
+}
+
+// LoadCSVFromPathWithSchema creates a DataFrame by loading a csv file from
+// a specific file system path, as LoadCSVFromPath does, converting the
+// columns as NewDataFrameWithSchema does.
+func LoadCSVFromPathWithSchema(path string, schema Schema) (*DataFrame, error) {
+	return LoadCSVFromZipWithSchema(path, "", schema)
+}
+
+// LoadCSVFromZipWithSchema creates a DataFrame by loading a csv file from
+// a zip archive, as LoadCSVFromZip does, converting the columns as
+// NewDataFrameWithSchema does. If schema is nil, the columns are
+// converted as NewDataFrame does.
+func LoadCSVFromZipWithSchema(path string, member string, schema Schema) (*DataFrame, error) {
+	data, err := readCSVFromPath(path, member)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDataFrame(data, schema)
+}
+
+// Validate checks the DataFrame against a schema, returning a report of
+// every violation. Missing (NaN) values are only checked by the nullable
+// rule.
+func (d *DataFrame) Validate(schema Schema) *ValidationReport {
+	r := ValidationReport{}
+
+	for _, c := range schema {
+		s, err := d.seriesByName(c.Name)
+		if err != nil {
+			r.Violations = append(r.Violations, Violation{Row: -1, Column: c.Name, Rule: RuleMissingColumn})
+			continue
+		}
+
+		r.Violations = append(r.Violations, s[0].validate(c)...)
+	}
+
+	return &r
+}
+
+func (s *Series) validate(c ColumnSchema) []Violation {
+	if s.IsCategorical() != (c.Kind == KindCategorical) {
+		return []Violation{{Row: -1, Column: c.Name, Rule: RuleKind}}
+	}
+
+	r := []Violation{}
+	seen := map[float64]bool{}
+	add := func(i int, rule Rule) {
+		r = append(r, Violation{Row: i, Column: c.Name, Value: s.label(s.Values[i]), Rule: rule})
+	}
+
+	for i, v := range s.Values {
+		if isNA(v) {
+			if c.Nullable == false {
+				add(i, RuleNullable)
+			}
+			continue
+		}
+
+		l := s.label(v)
+		if len(c.Categories) > 0 && s.IsCategorical() && containsString(l, c.Categories) == false {
+			add(i, RuleCategory)
+		}
+		if c.Min != nil && s.IsCategorical() == false && v < *c.Min {
+			add(i, RuleMin)
+		}
+		if c.Max != nil && s.IsCategorical() == false && v > *c.Max {
+			add(i, RuleMax)
+		}
+		if c.Pattern != nil && c.Pattern.MatchString(l) == false {
+			add(i, RulePattern)
+		}
+		if c.Unique && seen[v] {
+			add(i, RuleUnique)
+		}
+		seen[v] = true
+	}
+
+	return r
+}
+
+func (s Schema) column(n string) (ColumnSchema, bool) {
+	for _, c := range s {
+		if c.Name == n {
+			return c, true
+		}
+	}
+
+	return ColumnSchema{}, false
+}
@@ -0,0 +1,161 @@
+package gander
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func float(v float64) *float64 {
+	return &v
+}
+
+func createSchemaTestData() [][]string {
+	return [][]string{
+		{"1", "2", "3"},
+		{"1", "x", "10"},
+		{"2", "", "20"},
+		{"3", "7", ""},
+	}
+}
+
+func TestNewDataFrameWithSchema(t *testing.T) {
+	schema := Schema{
+		{Name: "1", Kind: KindNumeric},
+		{Name: "2", Kind: KindCategorical},
+		{Name: "3", Kind: KindNumeric},
+	}
+
+	df, err := NewDataFrameWithSchema(createSchemaTestData(), schema)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"1", "2", "3"}, df.ColumnNames(), "numeric headers should be used")
+	assert.Equal(t, []float64{1, 2, 3}, (*df)[0].Values, "values are not correct")
+	assert.Equal(t, true, (*df)[1].IsCategorical(), "Series should be categorical")
+	assert.Equal(t, "7", (*df)[1].label((*df)[1].Values[2]), "label is not correct")
+	assert.Equal(t, true, math.IsNaN((*df)[1].Values[1]), "empty label should be missing")
+	assert.Equal(t, true, valuesMatch([]float64{10, 20, na}, (*df)[2].Values), "values are not correct")
+}
+
+func TestNewDataFrameWithSchemaViolations(t *testing.T) {
+	schema := Schema{
+		{Name: "2", Kind: KindNumeric},
+		{Name: "4", Kind: KindNumeric},
+	}
+
+	_, err := NewDataFrameWithSchema(createSchemaTestData(), schema)
+	r, ok := err.(*ValidationReport)
+	assert.Equal(t, true, ok, "error should be a ValidationReport")
+	assert.Equal(t, []Violation{
+		{Row: -1, Column: "4", Rule: RuleMissingColumn},
+		{Row: 0, Column: "2", Value: "x", Rule: RuleKind},
+	}, r.Violations, "violations are not correct")
+	assert.Equal(t, "2 schema violations, the first is column '4': breaks the missing column rule", err.Error(), "error message is not correct")
+}
+
+func TestNewDataFrameWithSchemaInfersOtherColumns(t *testing.T) {
+	df, err := NewDataFrameWithSchema(createSchemaTestData(), Schema{{Name: "1", Kind: KindCategorical}})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, true, (*df)[0].IsCategorical(), "declared Series should be categorical")
+	assert.Equal(t, true, (*df)[1].IsCategorical(), "inferred Series should be categorical")
+}
+
+func TestNewDataFrameWithSchemaHeadersOnly(t *testing.T) {
+	df, err := NewDataFrameWithSchema([][]string{{"a", "b"}}, Schema{{Name: "a", Kind: KindCategorical}})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"a", "b"}, df.ColumnNames(), "column names are not correct")
+	assert.Equal(t, 0, df.Rows(), "DataFrame should have no rows")
+	assert.Equal(t, true, (*df)[0].IsCategorical(), "declared Series should be categorical")
+	assert.Equal(t, false, (*df)[1].IsCategorical(), "inferred Series should be numeric")
+}
+
+func TestLoadCSVFromPathWithSchemaHeadersOnly(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "gander")
+	defer os.RemoveAll(dir)
+	p := filepath.Join(dir, "data.csv")
+	ioutil.WriteFile(p, []byte("a,b\n"), 0644)
+
+	df, err := LoadCSVFromPathWithSchema(p, Schema{{Name: "a"}})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"a", "b"}, df.ColumnNames(), "column names are not correct")
+	assert.Equal(t, 0, df.Rows(), "DataFrame should have no rows")
+
+	p = filepath.Join(dir, "empty.csv")
+	ioutil.WriteFile(p, []byte{}, 0644)
+
+	_, err = LoadCSVFromPathWithSchema(p, Schema{{Name: "a"}})
+	assert.NotEqual(t, nil, err, "loading an empty file should return an error")
+}
+
+func TestLoadCSVFromPathWithSchema(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "gander")
+	defer os.RemoveAll(dir)
+	p := filepath.Join(dir, "data.csv")
+	ioutil.WriteFile(p, []byte("1,2\n3,a\n4,5\n"), 0644)
+
+	df, err := LoadCSVFromPathWithSchema(p, Schema{{Name: "2", Kind: KindCategorical}})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"1", "2"}, df.ColumnNames(), "column names are not correct")
+	assert.Equal(t, []float64{3, 4}, (*df)[0].Values, "values are not correct")
+}
+
+func TestLoadCSVFromZipWithSchema(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "gander")
+	defer os.RemoveAll(dir)
+	p := filepath.Join(dir, "data.zip")
+	createTestZip(t, p, map[string][]byte{"a.csv": []byte("1,2\n3,a\n4,5\n"), "b.csv": []byte("x\n1\n")})
+
+	df, err := LoadCSVFromZipWithSchema(p, "a.csv", Schema{{Name: "2", Kind: KindCategorical}})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"1", "2"}, df.ColumnNames(), "column names are not correct")
+	assert.Equal(t, true, (*df)[1].IsCategorical(), "column should be categorical")
+
+	_, err = LoadCSVFromZipWithSchema(p, "a.csv", Schema{{Name: "2", Kind: KindNumeric}})
+	assert.Equal(t, "row 0, column '2': value 'a' breaks the kind rule", err.Error(), "error is not correct")
+}
+
+func TestValidate(t *testing.T) {
+	df := &DataFrame{
+		NewSeries("age", []float64{30, -1, math.NaN(), 200}),
+		NewCategoricalSeries("code", []string{"ab1", "ab2", "zz", "ab1"}),
+		NewSeries("id", []float64{1, 2, 2, math.NaN()}),
+	}
+
+	schema := Schema{
+		{Name: "age", Kind: KindNumeric, Min: float(0), Max: float(120)},
+		{Name: "code", Kind: KindCategorical, Categories: []string{"ab1", "ab2"}, Pattern: regexp.MustCompile(`^ab\d$`)},
+		{Name: "id", Kind: KindNumeric, Nullable: true, Unique: true},
+		{Name: "name", Kind: KindCategorical},
+	}
+
+	r := df.Validate(schema)
+	assert.Equal(t, false, r.Valid(), "report should not be valid")
+	assert.Equal(t, []Violation{
+		{Row: 1, Column: "age", Value: "-1", Rule: RuleMin},
+		{Row: 2, Column: "age", Value: "NaN", Rule: RuleNullable},
+		{Row: 3, Column: "age", Value: "200", Rule: RuleMax},
+		{Row: 2, Column: "code", Value: "zz", Rule: RuleCategory},
+		{Row: 2, Column: "code", Value: "zz", Rule: RulePattern},
+		{Row: 2, Column: "id", Value: "2", Rule: RuleUnique},
+		{Row: -1, Column: "name", Rule: RuleMissingColumn},
+	}, r.Violations, "violations are not correct")
+}
+
+func TestValidateKind(t *testing.T) {
+	df := &DataFrame{NewSeries("a", []float64{1})}
+
+	r := df.Validate(Schema{{Name: "a", Kind: KindCategorical}})
+	assert.Equal(t, []Violation{{Row: -1, Column: "a", Rule: RuleKind}}, r.Violations, "violations are not correct")
+
+	r = df.Validate(Schema{{Name: "a", Kind: KindNumeric}})
+	assert.Equal(t, true, r.Valid(), "report should be valid")
+}
+
+func TestViolationString(t *testing.T) {
+	v := Violation{Row: 3, Column: "a", Value: "x", Rule: RuleKind}
+	assert.Equal(t, "row 3, column 'a': value 'x' breaks the kind rule", v.String(), "string is not correct")
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 )
 
 // A Series represents a column of data in a DataFrame.
@@ -57,29 +58,43 @@ func NewCategoricalSeries(name string, values []string) *Series {
 }
 
 // Standardize scales the values in the Series
-// to standard form.
+// to standard form. If all the values in the Series are
+// the same they are centred on zero, but not scaled.
+// Missing (NaN) values are left as they are.
 func (s *Series) Standardize() {
 	mu := s.Mean()
 	sigma := s.StdDev()
 
+	if sigma == 0 {
+		sigma = 1
+	}
+
 	for i, v := range s.Values {
 		s.Values[i] = (v - mu) / sigma
 	}
 }
 
-// Sum adds together all the values in the Series.
+// Sum adds together all the values in the Series,
+// skipping missing (NaN) values.
 func (s *Series) Sum() float64 {
-	return sum(s.Values)
+	return sum(s.valid())
 }
 
-// Mean finds the mean of all the values in the Series.
+// Mean finds the mean of all the values in the Series, skipping
+// missing (NaN) values. It returns NaN if there are no values.
 func (s *Series) Mean() float64 {
-	return s.Sum() / float64(len(s.Values))
+	v := s.valid()
+	return sum(v) / float64(len(v))
 }
 
-// Median finds the median of all the values in the Series.
+// Median finds the median of all the values in the Series, skipping
+// missing (NaN) values. It returns NaN if there are no values.
 func (s *Series) Median() float64 {
-	v := s.Sorted()
+	v := s.sortedValid()
+
+	if len(v) == 0 {
+		return math.NaN()
+	}
 
 	if len(v)%2 == 0 {
 		return (v[(len(v)/2)-1] + v[len(v)/2]) / 2
@@ -90,9 +105,10 @@ func (s *Series) Median() float64 {
 
 // Mode finds the mode of all the values in the Series. This returns
 // a slice ofr float64 because a Series could have more than one mode.
+// Missing (NaN) values are skipped.
 func (s *Series) Mode() []float64 {
 	m := []float64{}
-	c := count(s.Values)
+	c := count(s.valid())
 
 	var maxCount int
 
@@ -111,19 +127,22 @@ func (s *Series) Mode() []float64 {
 	return m
 }
 
-// Variance finds the variance of the values in the Series.
+// Variance finds the variance of the values in the Series,
+// skipping missing (NaN) values.
 func (s *Series) Variance() float64 {
-	mu := s.Mean()
-	sumOfSquares := sum(
-		s.Apply(
-			func(x float64) float64 {
-				return math.Pow(x-mu, 2)
-			}))
+	v := s.valid()
+	mu := sum(v) / float64(len(v))
+	sumOfSquares := 0.0
 
-	return sumOfSquares / float64(len(s.Values))
+	for _, x := range v {
+		sumOfSquares += math.Pow(x-mu, 2)
+	}
+
+	return sumOfSquares / float64(len(v))
 }
 
-// StdDev finds the standard deviation of the values in the Series.
+// StdDev finds the standard deviation of the values in the Series,
+// skipping missing (NaN) values.
 func (s *Series) StdDev() float64 {
 	return math.Sqrt(s.Variance())
 }
@@ -132,15 +151,41 @@ func (s *Series) IsCategorical() bool {
 	return s.categoricalLabels != nil
 }
 
-// Max returns the maximum value in the Series.
+// Quantile returns the value below which the fraction q of the values
+// in the Series fall, interpolating linearly between the two nearest
+// values where necessary. Missing (NaN) values are skipped. It returns
+// NaN if q is not between 0 and 1, or if there are no values.
+func (s *Series) Quantile(q float64) float64 {
+	v := s.sortedValid()
+
+	if q < 0 || q > 1 || len(v) == 0 {
+		return math.NaN()
+	}
+
+	return quantile(v, q)
+}
+
+// Max returns the maximum value in the Series, skipping missing
+// (NaN) values. It returns NaN if there are no values.
 func (s *Series) Max() float64 {
-	v := s.Sorted()
-	return v[len(s.Values)-1]
+	v := s.sortedValid()
+
+	if len(v) == 0 {
+		return math.NaN()
+	}
+
+	return v[len(v)-1]
 }
 
-// Min returns the minimum value in the Series.
+// Min returns the minimum value in the Series, skipping missing
+// (NaN) values. It returns NaN if there are no values.
 func (s *Series) Min() float64 {
-	v := s.Sorted()
+	v := s.sortedValid()
+
+	if len(v) == 0 {
+		return math.NaN()
+	}
+
 	return v[0]
 }
 
@@ -178,16 +223,43 @@ func (s *Series) Sorted() []float64 {
 	return r
 }
 
-// Hist returns a map of values to counts for categorical data.
-// It returns an error is the Series does not contain categorical data.
-func (s *Series) Hist() (map[string]int, error) {
+// sortedValid returns the values that are not missing, sorted.
+func (s *Series) sortedValid() []float64 {
+	r := s.valid()
+	sort.Float64s(r)
+	return r
+}
+
+// Hist returns a map of values to counts for categorical data. For
+// numeric data the number of bins must be provided, and the values
+// are counted in that many bins of equal width, using the same bins
+// and interval labels as Cut. It returns an error if the Series does not
+// contain categorical data and the number of bins is not provided.
+func (s *Series) Hist(bins ...int) (map[string]int, error) {
 	if s.IsCategorical() == false {
-		return nil, fmt.Errorf("Series %s is not categorical", s.Name)
+		if len(bins) == 0 {
+			return nil, fmt.Errorf("Series %s is not categorical", s.Name)
+		}
+
+		h, err := s.Histogram(bins[0])
+		if err != nil {
+			return nil, err
+		}
+
+		r := make(map[string]int)
+		for i, l := range intervalLabels(h.Edges, true, true) {
+			r[l] = h.Counts[i]
+		}
+
+		return r, nil
 	}
 
 	r := make(map[string]int)
 
 	for _, v := range s.Values {
+		if math.IsNaN(v) {
+			continue
+		}
 		c := s.categoricalLabels[v]
 		if _, ok := r[c]; ok {
 			r[c] += 1
@@ -199,6 +271,87 @@ func (s *Series) Hist() (map[string]int, error) {
 	return r, nil
 }
 
+// A ValueCount holds the number of times a value occurs in a Series.
+// Label is the categorical label for categorical data, or the value
+// formatted as a string for numeric data. Proportion is the count as
+// a proportion of the number of values in the Series, if it was asked
+// for, and is zero otherwise.
+type ValueCount struct {
+	Label      string
+	Value      float64
+	Count      int
+	Proportion float64
+}
+
+// Unique returns a new Series holding each distinct value of the
+// Series once, in the order in which they first appear. The categorical
+// labels of a categorical Series are preserved. All missing (NaN) values
+// are treated as the same value, so NaN appears at most once.
+func (s *Series) Unique() *Series {
+	r := s.emptyCopy()
+	seen := map[float64]bool{}
+	seenNA := false
+
+	for _, v := range s.Values {
+		if isNA(v) == true {
+			if seenNA == false {
+				seenNA = true
+				r.Values = append(r.Values, v)
+			}
+			continue
+		}
+		if seen[v] == false {
+			seen[v] = true
+			r.Values = append(r.Values, v)
+		}
+	}
+
+	return r
+}
+
+// NUnique returns the number of distinct values in the Series,
+// counting all missing (NaN) values as a single value.
+func (s *Series) NUnique() int {
+	return len(s.Unique().Values)
+}
+
+// ValueCounts returns the number of times each distinct value occurs
+// in the Series. If normalize is true, the proportion of the values in
+// the Series that each count makes up is also returned. If sorted is true,
+// the results are ordered by descending count, otherwise they are in the
+// order in which each value first appears. All missing (NaN) values are
+// counted together, as a single value.
+func (s *Series) ValueCounts(normalize bool, sorted bool) []ValueCount {
+	c := count(s.Values)
+	na := 0
+	for _, v := range s.Values {
+		if isNA(v) == true {
+			na++
+		}
+	}
+
+	r := []ValueCount{}
+
+	for _, v := range s.Unique().Values {
+		vc := ValueCount{Label: s.label(v), Value: v, Count: c[v]}
+		if isNA(v) == true {
+			vc.Count = na
+		}
+		if normalize == true {
+			vc.Proportion = float64(vc.Count) / float64(len(s.Values))
+		}
+		r = append(r, vc)
+	}
+
+	if sorted == true {
+		sort.SliceStable(r, func(i, j int) bool {
+			return r[i].Count > r[j].Count
+		})
+	}
+
+	return r
+}
+
 // Describe returns a summary of the statisical properties
 // of all the Series.
 func (s *Series) Describe() Summary {
@@ -224,6 +377,19 @@ func sum(r []float64) float64 {
 	return t
 }
 
+// quantile expects r to be sorted.
+func quantile(r []float64, q float64) float64 {
+	h := float64(len(r)-1) * q
+	lo := math.Floor(h)
+	i := int(lo)
+
+	if i+1 >= len(r) {
+		return r[len(r)-1]
+	}
+
+	return r[i] + (h-lo)*(r[i+1]-r[i])
+}
+
 func count(r []float64) map[float64]int {
 	m := map[float64]int{}
 
@@ -238,6 +404,55 @@ func count(r []float64) map[float64]int {
 	return m
 }
 
-func (s *Series) dropRow(r int) {
-	s.Values = append(s.Values[:r], s.Values[r+1:]...)
+// label returns the categorical label for v, or v formatted
+// as a string if the Series is not categorical.
+func (s *Series) label(v float64) string {
+	if s.IsCategorical() == true {
+		return s.categoricalLabels[v]
+	}
+
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// emptyCopy returns a Series with the same name and categories
+// as s, but with no values.
+func (s *Series) emptyCopy() *Series {
+	r := Series{Name: s.Name, Values: []float64{}}
+
+	if s.IsCategorical() == true {
+		r.categoricalLabels = make(map[float64]string)
+		r.categoricalValues = make(map[string]float64)
+		for k, v := range s.categoricalLabels {
+			r.categoricalLabels[k] = v
+			r.categoricalValues[v] = k
+		}
+	}
+
+	return &r
+}
+
+// Clone returns a deep copy of the Series. The copy shares no
+// values or categories with s, so either can be modified without
+// affecting the other.
+func (s *Series) Clone() *Series {
+	r := s.emptyCopy()
+	r.Values = make([]float64, len(s.Values))
+	copy(r.Values, s.Values)
+
+	return r
+}
+
+// keepRows removes the values where the mask is false, moving
+// the remaining values down in place.
+func (s *Series) keepRows(keep []bool) {
+	n := 0
+
+	for i, v := range s.Values {
+		if keep[i] {
+			s.Values[n] = v
+			n++
+		}
+	}
+
+	s.Values = s.Values[:n]
 }
@@ -190,3 +190,115 @@ func TestHistOfNonCategoricalData(t *testing.T) {
 	_, err := s.Hist()
 	assert.Equal(t, errors.New("Series MySeries is not categorical"), err, "did not return correct error")
 }
+
+func TestUniqueNumericData(t *testing.T) {
+	s := createTestSeries()
+	u := s.Unique()
+	e := []float64{0, 2, 7, 1, 4, 3}
+	assert.Equal(t, e, u.Values, "unique values are not correct")
+	assert.Equal(t, 6, s.NUnique(), "number of unique values is not correct")
+}
+
+func TestUniqueCategoricalData(t *testing.T) {
+	s := createTestCategoricalSeries()
+	u := s.Unique()
+	assert.Equal(t, true, u.IsCategorical(), "unique series is not categorical")
+	assert.Equal(t, 4, len(u.Values), "wrong number of unique values")
+	assert.Equal(t, "c", u.categoricalLabels[u.Values[2]], "unique values are not in order of appearance")
+	assert.Equal(t, 4, s.NUnique(), "number of unique values is not correct")
+}
+
+func TestUniqueWithMissingValues(t *testing.T) {
+	s := NewSeries("MySeries", []float64{1, math.NaN(), 2, math.NaN()})
+	u := s.Unique()
+	assert.Equal(t, 3, len(u.Values), "wrong number of unique values")
+	assert.Equal(t, true, math.IsNaN(u.Values[1]), "missing value is not in order of appearance")
+	assert.Equal(t, 3, s.NUnique(), "number of unique values is not correct")
+
+	c := s.ValueCounts(false, true)
+	assert.Equal(t, 3, len(c), "wrong number of value counts")
+	assert.Equal(t, "NaN", c[0].Label, "label is not correct")
+	assert.Equal(t, 2, c[0].Count, "missing values are not counted together")
+}
+
+func TestValueCountsSorted(t *testing.T) {
+	s := createTestCategoricalSeries()
+	c := s.ValueCounts(false, true)
+	e := []ValueCount{
+		{Label: "a", Value: 0, Count: 4},
+		{Label: "b", Value: 1, Count: 3},
+		{Label: "c", Value: 2, Count: 2},
+		{Label: "d", Value: 3, Count: 1},
+	}
+	assert.Equal(t, e, c, "value counts are not correct")
+}
+
+func TestValueCountsNormalized(t *testing.T) {
+	s := createTestSeries()
+	c := s.ValueCounts(true, false)
+	assert.Equal(t, 6, len(c), "wrong number of value counts")
+	assert.Equal(t, "0", c[0].Label, "label is not correct")
+	assert.Equal(t, 1, c[0].Count, "count is not correct")
+	assert.Equal(t, 0.1, c[0].Proportion, "proportion is not correct")
+	assert.Equal(t, "7", c[2].Label, "value counts are not in order of appearance")
+	assert.Equal(t, 2, c[2].Count, "count is not correct")
+	assert.Equal(t, 0.2, c[2].Proportion, "proportion is not correct")
+}
+
+func TestQuantile(t *testing.T) {
+	s := createTestSeries() // 0, 1, 1, 2, 3, 3, 4, 4, 7, 7
+	assert.Equal(t, 0.0, s.Quantile(0), "minimum is not correct")
+	assert.Equal(t, 1.25, s.Quantile(0.25), "lower quartile is not correct")
+	assert.Equal(t, 3.0, s.Quantile(0.5), "median is not correct")
+	assert.Equal(t, 4.0, s.Quantile(0.75), "upper quartile is not correct")
+	assert.Equal(t, 7.0, s.Quantile(1), "maximum is not correct")
+	assert.Equal(t, true, math.IsNaN(s.Quantile(1.5)), "out of range quantile is not NaN")
+}
+
+func TestStandardizeConstantValues(t *testing.T) {
+	s := NewSeries("MySeries", []float64{2, 2, 2})
+	s.Standardize()
+	assert.Equal(t, []float64{0, 0, 0}, s.Values, "constant values are not centred")
+}
+
+func TestStatsSkipMissingValues(t *testing.T) {
+	s := NewSeries("MySeries", []float64{4, math.NaN(), 1, 3, math.NaN(), 2})
+	assert.Equal(t, 10.0, s.Sum(), "sum is not correct")
+	assert.Equal(t, 2.5, s.Mean(), "mean is not correct")
+	assert.Equal(t, 2.5, s.Median(), "median is not correct")
+	assert.Equal(t, 1.25, s.Variance(), "variance is not correct")
+	assert.Equal(t, 1.0, s.Min(), "minimum is not correct")
+	assert.Equal(t, 4.0, s.Max(), "maximum is not correct")
+	assert.Equal(t, 1.75, s.Quantile(0.25), "lower quartile is not correct")
+	assert.Equal(t, 4, len(s.Mode()), "mode should not include missing values")
+}
+
+func TestStatsOfMissingValues(t *testing.T) {
+	s := NewSeries("MySeries", []float64{math.NaN(), math.NaN()})
+	assert.Equal(t, 0.0, s.Sum(), "sum is not correct")
+	assert.Equal(t, true, math.IsNaN(s.Mean()), "mean is not NaN")
+	assert.Equal(t, true, math.IsNaN(s.Median()), "median is not NaN")
+	assert.Equal(t, true, math.IsNaN(s.Min()), "minimum is not NaN")
+	assert.Equal(t, true, math.IsNaN(s.Max()), "maximum is not NaN")
+	assert.Equal(t, []float64{}, s.Mode(), "mode is not empty")
+}
+
+func TestStandardizeWithMissingValues(t *testing.T) {
+	s := NewSeries("MySeries", []float64{1, math.NaN(), 3})
+	s.Standardize()
+	assert.Equal(t, -1.0, s.Values[0], "value is not standardized")
+	assert.Equal(t, true, math.IsNaN(s.Values[1]), "missing value should stay missing")
+	assert.Equal(t, 1.0, s.Values[2], "value is not standardized")
+}
+
+func TestSeriesClone(t *testing.T) {
+	s := NewCategoricalSeries("MySeries", []string{"a", "b", "a"})
+	c := s.Clone()
+	assert.Equal(t, s, c, "clone is not equal to the original Series")
+
+	c.appendLabel("c")
+	c.Values[0] = c.Values[3]
+	assert.Equal(t, "a", s.label(s.Values[0]), "original Series values were changed")
+	assert.Equal(t, 2, len(s.categoricalLabels), "original Series categories were changed")
+	assert.Equal(t, "c", c.label(c.Values[0]), "clone values were not changed")
+}
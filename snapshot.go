@@ -0,0 +1,64 @@
+package gander
+
+import (
+	"fmt"
+)
+
+// A Snapshot is a copy of a DataFrame that cannot be changed. All of
+// its methods can be called from several goroutines at the same time,
+// including while the DataFrame it was taken from is being changed.
+type Snapshot struct {
+	d DataFrame
+}
+
+// Snapshot returns a Snapshot of the DataFrame as it is now. The
+// values are copied, so later changes to d are not seen by the
+// Snapshot. It must not be called at the same time as a method that
+// changes d.
+func (d *DataFrame) Snapshot() *Snapshot {
+	return &Snapshot{d: *d.Clone()}
+}
+
+// DataFrame returns a new DataFrame holding a copy of the values in
+// the Snapshot, which can be changed without affecting the Snapshot.
+func (s *Snapshot) DataFrame() *DataFrame {
+	return s.d.Clone()
+}
+
+// Column returns a copy of the named Series.
+func (s *Snapshot) Column(name string) (*Series, error) {
+	for _, v := range s.d {
+		if v.Name == name {
+			return v.Clone(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("column '%s' does not exist in the DataFrame", name)
+}
+
+// ColumnNames returns the names of all the columns in the Snapshot.
+func (s *Snapshot) ColumnNames() []string {
+	return s.d.ColumnNames()
+}
+
+// Columns returns the number of columns in the Snapshot.
+func (s *Snapshot) Columns() int {
+	return s.d.Columns()
+}
+
+// Rows returns the number of rows in the Snapshot.
+func (s *Snapshot) Rows() int {
+	return s.d.Rows()
+}
+
+// Describe returns a summary of the statisical properties
+// of all the Series in the Snapshot.
+func (s *Snapshot) Describe() []Summary {
+	return s.d.Describe()
+}
+
+// String returns a tabular representation of the Snapshot,
+// as DataFrame.String does.
+func (s *Snapshot) String() string {
+	return s.d.String()
+}
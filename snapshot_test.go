@@ -0,0 +1,139 @@
+package gander
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The concurrent tests below are most useful when run
+// with the race detector, using go test -race.
+
+func TestSnapshot(t *testing.T) {
+	df, err := NewDataFrame(createSampleDataWithHeaders())
+	assert.Equal(t, nil, err, "error is not nil")
+	s := df.Snapshot()
+
+	df.Standardize()
+	df.DropRows(0)
+	df.DropColumns(0)
+
+	assert.Equal(t, 5, s.Columns(), "snapshot does not have the correct number of columns")
+	assert.Equal(t, 4, s.Rows(), "snapshot does not have the correct number of rows")
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, s.ColumnNames(), "snapshot column names are not correct")
+
+	c, err := s.Column("a")
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []float64{1, 3, 7, 4}, c.Values, "snapshot values were changed")
+
+	c.Values[0] = 100
+	c, _ = s.Column("a")
+	assert.Equal(t, float64(1), c.Values[0], "changing a returned Series should not change the snapshot")
+}
+
+func TestSnapshotInvalidColumn(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+	_, err := df.Snapshot().Column("x")
+	assert.Equal(t, "column 'x' does not exist in the DataFrame", err.Error(), "error is not correct")
+}
+
+func TestSnapshotDataFrame(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+	s := df.Snapshot()
+
+	d := s.DataFrame()
+	assert.Equal(t, df, d, "DataFrame does not match the snapshot")
+
+	d.Standardize()
+	c, _ := s.Column("a")
+	assert.Equal(t, []float64{1, 3, 7, 4}, c.Values, "changing the DataFrame should not change the snapshot")
+}
+
+func TestConcurrentReads(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+	want := df.String()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			df.Describe()
+			df.ColumnNames()
+			assert.Equal(t, want, df.String(), "string is not correct")
+		}()
+	}
+	wg.Wait()
+}
+
+func TestConcurrentClones(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c := df.Clone()
+			c.Standardize()
+			c.DropRows(i % 4)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 4, df.Rows(), "original DataFrame rows were changed")
+	assert.Equal(t, []float64{1, 3, 7, 4}, (*df)[0].Values, "original DataFrame values were changed")
+}
+
+func TestSnapshotWhileModifying(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+	s := df.Snapshot()
+	want := s.String()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			df.Standardize()
+			df.FFill(0)
+		}
+		df.DropRows(0, 1)
+	}()
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				s.Describe()
+				assert.Equal(t, want, s.String(), "snapshot string was changed")
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 4, s.Rows(), "snapshot rows were changed")
+}
+
+func TestSetFormatOptionsConcurrently(t *testing.T) {
+	defer SetFormatOptions(DefaultFormatOptions())
+	df, _ := NewDataFrame(createSampleDataWithHeaders())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			o := DefaultFormatOptions()
+			o.Precision = i
+			SetFormatOptions(o)
+		}(i)
+		go func() {
+			defer wg.Done()
+			assert.NotEqual(t, "", df.String(), "string should not be empty")
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,331 @@
+package gander
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SQLWriteMode specifies what WriteSQL does with the table.
+type SQLWriteMode int
+
+const (
+	// SQLCreate creates a new table, failing if it already exists.
+	SQLCreate SQLWriteMode = iota
+	// SQLAppend inserts the rows into an existing table.
+	SQLAppend
+	// SQLReplace drops the table if it exists and creates it again.
+	SQLReplace
+)
+
+// SQLPlaceholder is the style of the parameter placeholders used in the
+// statements written by WriteSQLWithOptions, which depends on the driver.
+type SQLPlaceholder int
+
+const (
+	// SQLQuestion uses ? for every parameter, as the SQLite and MySQL
+	// drivers expect.
+	SQLQuestion SQLPlaceholder = iota
+	// SQLDollar numbers the parameters $1, $2 and so on, as the
+	// PostgreSQL drivers expect.
+	SQLDollar
+)
+
+// SQLOptions controls how WriteSQLWithOptions writes a table.
+type SQLOptions struct {
+	// Schema, if not empty, is the schema holding the table.
+	Schema string
+	// Placeholder is the style of the parameter placeholders.
+	Placeholder SQLPlaceholder
+}
+
+// sqlMaxParameters is the number of parameters used in each insert
+// statement, which is kept below the lowest limit of common databases.
+const sqlMaxParameters = 999
+
+// LoadSQL creates a DataFrame from the rows of a query result, using the
+// column names of the result. The type of each column is chosen from its
+// database type where this is known, so text columns become categorical
+// Series even if their values look like numbers. Otherwise a column is
+// numeric if all its values are numbers, booleans or times, which are
+// converted to Unix timestamps in seconds. NULLs become NaN. LoadSQL
+// reads all the remaining rows but does not close rows.
+func LoadSQL(rows *sql.Rows) (*DataFrame, error) {
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([][]interface{}, len(types))
+
+	for rows.Next() {
+		r := make([]interface{}, len(types))
+		p := make([]interface{}, len(types))
+		for i := range r {
+			p[i] = &r[i]
+		}
+
+		if err := rows.Scan(p...); err != nil {
+			return nil, err
+		}
+
+		for i, v := range r {
+			if b, ok := v.([]byte); ok {
+				v = string(b)
+			}
+			data[i] = append(data[i], v)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	d := DataFrame{}
+	for i, t := range types {
+		d = append(d, createSQLSeries(t, data[i]))
+	}
+
+	return &d, nil
+}
+
+// LoadQuery runs a query and creates a DataFrame from the result,
+// as LoadSQL does.
+func LoadQuery(ctx context.Context, db *sql.DB, query string, args ...interface{}) (*DataFrame, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	return LoadSQL(rows)
+}
+
+// WriteSQL writes the DataFrame to a database table, in a single
+// transaction, inserting the rows in batches. Tables are created with a
+// BIGINT column for each numeric Series holding only whole numbers, a
+// DOUBLE PRECISION column for other numeric Series and a TEXT column for
+// categorical Series. Missing (NaN) values are written as NULL. The table
+// name is quoted as a single identifier, so it may contain dots. WriteSQL
+// uses ? as the parameter placeholder, which the PostgreSQL drivers do not
+// accept; use WriteSQLWithOptions with SQLDollar for those.
+func (d *DataFrame) WriteSQL(ctx context.Context, db *sql.DB, table string, mode SQLWriteMode) error {
+	return d.WriteSQLWithOptions(ctx, db, table, mode, SQLOptions{})
+}
+
+// WriteSQLWithOptions writes the DataFrame to a database table as WriteSQL
+// does, in the schema and with the placeholder style given by opts.
+func (d *DataFrame) WriteSQLWithOptions(ctx context.Context, db *sql.DB, table string, mode SQLWriteMode, opts SQLOptions) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := d.writeSQL(ctx, tx, quoteTableName(opts.Schema, table), opts.Placeholder, mode); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// writeSQL writes the DataFrame to the table, whose name must
+// already be quoted.
+func (d *DataFrame) writeSQL(ctx context.Context, tx *sql.Tx, table string, placeholder SQLPlaceholder, mode SQLWriteMode) error {
+	if mode == SQLReplace {
+		if _, err := tx.ExecContext(ctx, "DROP TABLE IF EXISTS "+table); err != nil {
+			return err
+		}
+	}
+
+	if mode == SQLCreate || mode == SQLReplace {
+		if _, err := tx.ExecContext(ctx, d.createTableSQL(table)); err != nil {
+			return err
+		}
+	} else if mode != SQLAppend {
+		return fmt.Errorf("unknown SQL write mode %d", mode)
+	}
+
+	if d.Columns() == 0 {
+		return nil
+	}
+
+	cols := []string{}
+	ints := []bool{}
+	for _, s := range *d {
+		cols = append(cols, quoteIdentifier(s.Name))
+		ints = append(ints, s.isInt64())
+	}
+	insert := "INSERT INTO " + table + " (" + strings.Join(cols, ", ") + ") VALUES "
+
+	batch := sqlMaxParameters / d.Columns()
+	if batch < 1 {
+		batch = 1
+	}
+
+	for start := 0; start < d.Rows(); start += batch {
+		end := start + batch
+		if end > d.Rows() {
+			end = d.Rows()
+		}
+
+		rows := []string{}
+		args := []interface{}{}
+		for r := start; r < end; r++ {
+			p := []string{}
+			for i, s := range *d {
+				args = append(args, s.sqlValue(r, ints[i]))
+				p = append(p, placeholder.format(len(args)))
+			}
+			rows = append(rows, "("+strings.Join(p, ", ")+")")
+		}
+
+		if _, err := tx.ExecContext(ctx, insert+strings.Join(rows, ", "), args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *DataFrame) createTableSQL(table string) string {
+	cols := []string{}
+
+	for _, s := range *d {
+		t := "DOUBLE PRECISION"
+		if s.IsCategorical() {
+			t = "TEXT"
+		} else if s.isInt64() {
+			t = "BIGINT"
+		}
+		cols = append(cols, quoteIdentifier(s.Name)+" "+t)
+	}
+
+	return "CREATE TABLE " + table + " (" + strings.Join(cols, ", ") + ")"
+}
+
+// sqlValue returns the value in row r as a parameter for an insert,
+// converted to an int64 if integer is true.
+func (s *Series) sqlValue(r int, integer bool) interface{} {
+	v := s.Values[r]
+
+	switch {
+	case isNA(v):
+		return nil
+	case s.IsCategorical():
+		return s.categoricalLabels[v]
+	case integer:
+		return int64(v)
+	}
+
+	return v
+}
+
+// format returns the placeholder for the n'th parameter.
+func (p SQLPlaceholder) format(n int) string {
+	if p == SQLDollar {
+		return "$" + strconv.Itoa(n)
+	}
+
+	return "?"
+}
+
+func quoteIdentifier(n string) string {
+	return `"` + strings.Replace(n, `"`, `""`, -1) + `"`
+}
+
+// quoteTableName quotes a table name, qualified
+// with the schema if it is not empty.
+func quoteTableName(schema string, table string) string {
+	if schema == "" {
+		return quoteIdentifier(table)
+	}
+
+	return quoteIdentifier(schema) + "." + quoteIdentifier(table)
+}
+
+func createSQLSeries(t *sql.ColumnType, data []interface{}) *Series {
+	values := []float64{}
+	numeric := isSQLTextType(t.DatabaseTypeName()) == false
+
+	for _, v := range data {
+		if numeric == false {
+			break
+		}
+		f, ok := sqlNumber(v)
+		numeric = ok
+		values = append(values, f)
+	}
+
+	if numeric {
+		return NewSeries(t.Name(), values)
+	}
+
+	s := NewCategoricalSeries(t.Name(), []string{})
+	for _, v := range data {
+		if v == nil {
+			s.Values = append(s.Values, math.NaN())
+		} else if tm, ok := v.(time.Time); ok {
+			s.appendLabel(tm.Format(time.RFC3339Nano))
+		} else {
+			s.appendLabel(fmt.Sprint(v))
+		}
+	}
+
+	return s
+}
+
+// sqlNumber converts a scanned value to a float64, returning
+// false if this is not possible.
+func sqlNumber(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case nil:
+		return math.NaN(), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	case time.Time:
+		return float64(v.UnixNano()) / 1e9, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+
+	r := reflect.ValueOf(v)
+	switch r.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return float64(r.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(r.Uint()), true
+	case reflect.Float32:
+		return r.Float(), true
+	}
+
+	return 0, false
+}
+
+// isSQLTextType returns true if a database type name is for
+// a character or text type.
+func isSQLTextType(t string) bool {
+	t = strings.ToUpper(t)
+
+	for _, v := range []string{"CHAR", "TEXT", "CLOB", "STRING", "UUID", "JSON", "ENUM"} {
+		if strings.Contains(t, v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,173 @@
+package gander
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	_ "modernc.org/sqlite"
+)
+
+func openTestDatabase(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// each connection to :memory: is a separate database
+	db.SetMaxOpenConns(1)
+
+	return db
+}
+
+func TestLoadQuery(t *testing.T) {
+	db := openTestDatabase(t)
+	defer db.Close()
+
+	db.Exec(`CREATE TABLE t (i INTEGER, r REAL, c TEXT, n VARCHAR(10))`)
+	db.Exec(`INSERT INTO t VALUES (1, 1.5, 'x', '10'), (2, NULL, 'y', '20'), (3, 3.5, NULL, '30')`)
+
+	df, err := LoadQuery(context.Background(), db, `SELECT * FROM t WHERE i > ?`, 0)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"i", "r", "c", "n"}, df.ColumnNames(), "column names are not correct")
+	assert.Equal(t, []float64{1, 2, 3}, (*df)[0].Values, "integer values are not correct")
+	assert.Equal(t, true, valuesMatch([]float64{1.5, na, 3.5}, (*df)[1].Values), "real values are not correct")
+	assert.Equal(t, true, (*df)[2].IsCategorical(), "text column should be categorical")
+	assert.Equal(t, true, math.IsNaN((*df)[2].Values[2]), "NULL should be NaN")
+	assert.Equal(t, true, (*df)[3].IsCategorical(), "varchar column of numbers should be categorical")
+	assert.Equal(t, "20", (*df)[3].label((*df)[3].Values[1]), "label is not correct")
+}
+
+func TestLoadQueryExpression(t *testing.T) {
+	db := openTestDatabase(t)
+	defer db.Close()
+
+	df, err := LoadQuery(context.Background(), db, `SELECT 1 + 1 AS a, 'b' AS b`)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []float64{2}, (*df)[0].Values, "value is not correct")
+	assert.Equal(t, true, (*df)[1].IsCategorical(), "text value should be categorical")
+}
+
+func TestWriteSQL(t *testing.T) {
+	db := openTestDatabase(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	df := &DataFrame{
+		NewSeries("a", []float64{1, 2, math.NaN()}),
+		NewSeries("b c", []float64{0.5, 1.5, 2.5}),
+		NewCategoricalSeries("d", []string{"x", "y", "x"}),
+	}
+
+	err := df.WriteSQL(ctx, db, "t", SQLCreate)
+	assert.Equal(t, nil, err, "error is not nil")
+
+	r, err := LoadQuery(ctx, db, `SELECT * FROM t`)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"a", "b c", "d"}, r.ColumnNames(), "column names are not correct")
+	assert.Equal(t, true, valuesMatch([]float64{1, 2, na}, (*r)[0].Values), "values are not correct")
+	assert.Equal(t, []float64{0.5, 1.5, 2.5}, (*r)[1].Values, "values are not correct")
+	assert.Equal(t, "y", (*r)[2].label((*r)[2].Values[1]), "label is not correct")
+
+	var typ string
+	db.QueryRow(`SELECT type FROM pragma_table_info('t') WHERE name = 'a'`).Scan(&typ)
+	assert.Equal(t, "BIGINT", typ, "whole numbers should be written as BIGINT")
+
+	err = df.WriteSQL(ctx, db, "t", SQLCreate)
+	assert.NotEqual(t, nil, err, "creating an existing table should fail")
+
+	err = df.WriteSQL(ctx, db, "t", SQLAppend)
+	assert.Equal(t, nil, err, "error is not nil")
+	r, _ = LoadQuery(ctx, db, `SELECT * FROM t`)
+	assert.Equal(t, 6, r.Rows(), "rows should be appended")
+
+	err = df.WriteSQL(ctx, db, "t", SQLReplace)
+	assert.Equal(t, nil, err, "error is not nil")
+	r, _ = LoadQuery(ctx, db, `SELECT * FROM t`)
+	assert.Equal(t, 3, r.Rows(), "table should be replaced")
+}
+
+func TestWriteSQLQualifiedTableName(t *testing.T) {
+	db := openTestDatabase(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	df := &DataFrame{NewSeries("a", []float64{1, 2})}
+	opts := SQLOptions{Schema: "main"}
+	err := df.WriteSQLWithOptions(ctx, db, "t", SQLCreate, opts)
+	assert.Equal(t, nil, err, "error is not nil")
+	err = df.WriteSQLWithOptions(ctx, db, "t", SQLAppend, opts)
+	assert.Equal(t, nil, err, "error is not nil")
+
+	r, err := LoadQuery(ctx, db, `SELECT a FROM t`)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []float64{1, 2, 1, 2}, (*r)[0].Values, "table was not created in the schema")
+	assert.Equal(t, `"s"."t""x"`, quoteTableName("s", `t"x`), "table name is not quoted correctly")
+}
+
+func TestWriteSQLDottedTableName(t *testing.T) {
+	db := openTestDatabase(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	df := &DataFrame{NewSeries("a", []float64{1, 2})}
+	err := df.WriteSQL(ctx, db, "sales.2020", SQLCreate)
+	assert.Equal(t, nil, err, "error is not nil")
+
+	r, err := LoadQuery(ctx, db, `SELECT a FROM "sales.2020"`)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []float64{1, 2}, (*r)[0].Values, "values are not correct")
+	assert.Equal(t, `"sales.2020"`, quoteTableName("", "sales.2020"), "table name is not quoted correctly")
+}
+
+func TestWriteSQLPlaceholders(t *testing.T) {
+	db := openTestDatabase(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	df := &DataFrame{NewSeries("a", []float64{1, 2}), NewCategoricalSeries("b", []string{"x", "y"})}
+	err := df.WriteSQLWithOptions(ctx, db, "t", SQLCreate, SQLOptions{Placeholder: SQLDollar})
+	assert.Equal(t, nil, err, "error is not nil")
+
+	r, _ := LoadQuery(ctx, db, `SELECT * FROM t`)
+	assert.Equal(t, []float64{1, 2}, (*r)[0].Values, "values are not correct")
+	assert.Equal(t, "y", (*r)[1].label((*r)[1].Values[1]), "label is not correct")
+	assert.Equal(t, "?", SQLQuestion.format(3), "placeholder is not correct")
+	assert.Equal(t, "$3", SQLDollar.format(3), "placeholder is not correct")
+}
+
+func TestWriteSQLBatches(t *testing.T) {
+	db := openTestDatabase(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	v := make([]float64, 2500)
+	for i := range v {
+		v[i] = float64(i)
+	}
+	df := &DataFrame{NewSeries("a", v)}
+
+	err := df.WriteSQL(ctx, db, "t", SQLCreate)
+	assert.Equal(t, nil, err, "error is not nil")
+
+	r, _ := LoadQuery(ctx, db, `SELECT SUM(a) AS s, COUNT(*) AS n FROM t`)
+	assert.Equal(t, []float64{float64(2499 * 2500 / 2)}, (*r)[0].Values, "sum is not correct")
+	assert.Equal(t, []float64{2500}, (*r)[1].Values, "count is not correct")
+}
+
+func TestWriteSQLRollback(t *testing.T) {
+	db := openTestDatabase(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	db.Exec(`CREATE TABLE t (a INTEGER NOT NULL)`)
+	df := &DataFrame{NewSeries("a", []float64{1, math.NaN()})}
+
+	err := df.WriteSQL(ctx, db, "t", SQLAppend)
+	assert.NotEqual(t, nil, err, "error is nil")
+
+	r, _ := LoadQuery(ctx, db, `SELECT COUNT(*) AS n FROM t`)
+	assert.Equal(t, []float64{0}, (*r)[0].Values, "insert should be rolled back")
+}
@@ -0,0 +1,284 @@
+package gander
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// structField is a struct field mapped to a column.
+type structField struct {
+	name  string
+	index int
+	typ   reflect.Type
+}
+
+// FromStructs creates a DataFrame from a slice of structs, or of pointers
+// to structs, with a Series for each exported field. The column name is
+// taken from a `gander:"name"` tag, or is the field name if there is no
+// tag, and fields tagged `gander:"-"` are skipped. String fields become
+// categorical Series. Numeric and bool fields become numeric Series, as
+// do time.Time fields, which are converted to Unix timestamps in seconds.
+// Fields may also be pointers to these types, with nil pointers becoming
+// missing (NaN) values.
+func FromStructs(slice interface{}) (*DataFrame, error) {
+	v := reflect.ValueOf(slice)
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("FromStructs requires a slice of structs, not %T", slice)
+	}
+
+	t, err := structType(v.Type().Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := structFields(t)
+	if err != nil {
+		return nil, err
+	}
+
+	d := DataFrame{}
+	for _, f := range fields {
+		var s *Series
+		if isStringType(f.typ) {
+			s = NewCategoricalSeries(f.name, []string{})
+		} else {
+			s = NewSeries(f.name, []float64{})
+		}
+		d = append(d, s)
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		e := v.Index(i)
+		if e.Kind() == reflect.Ptr {
+			if e.IsNil() {
+				return nil, fmt.Errorf("element %d of the slice is nil", i)
+			}
+			e = e.Elem()
+		}
+
+		for j, f := range fields {
+			fv := e.Field(f.index)
+			s := d[j]
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					s.Values = append(s.Values, math.NaN())
+					continue
+				}
+				fv = fv.Elem()
+			}
+
+			if s.IsCategorical() {
+				s.appendLabel(fv.String())
+			} else {
+				s.Values = append(s.Values, structValue(fv))
+			}
+		}
+	}
+
+	return &d, nil
+}
+
+// ToStructs fills the slice of structs, or of pointers to structs, that
+// out points to with a struct for each row of the DataFrame. Fields are
+// mapped to columns as they are by FromStructs. Categorical labels are
+// stored in string fields, and numeric values are converted to the type
+// of the field. Missing (NaN) values are stored as nil pointers, or as
+// empty strings in string fields. An error is returned if a column does
+// not exist or a value cannot be stored in its field.
+func (d *DataFrame) ToStructs(out interface{}) error {
+	p := reflect.ValueOf(out)
+	if p.Kind() != reflect.Ptr || p.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("ToStructs requires a pointer to a slice of structs, not %T", out)
+	}
+
+	v := p.Elem()
+	et := v.Type().Elem()
+	t, err := structType(et)
+	if err != nil {
+		return err
+	}
+
+	fields, err := structFields(t)
+	if err != nil {
+		return err
+	}
+
+	cols := []*Series{}
+	for _, f := range fields {
+		s, err := d.seriesByName(f.name)
+		if err != nil {
+			return err
+		}
+		if s[0].IsCategorical() && isStringType(f.typ) == false {
+			return fmt.Errorf("Series %s is categorical and cannot be stored in field of type %s", f.name, f.typ)
+		}
+		cols = append(cols, s[0])
+	}
+
+	r := reflect.MakeSlice(v.Type(), d.rowCount(), d.rowCount())
+	for i := 0; i < d.rowCount(); i++ {
+		e := r.Index(i)
+		if et.Kind() == reflect.Ptr {
+			e.Set(reflect.New(t))
+			e = e.Elem()
+		}
+
+		for j, f := range fields {
+			if err := cols[j].setField(e.Field(f.index), i); err != nil {
+				return err
+			}
+		}
+	}
+
+	v.Set(r)
+
+	return nil
+}
+
+// setField stores the value in row i of the Series in a struct field.
+func (s *Series) setField(f reflect.Value, i int) error {
+	v := s.Values[i]
+
+	if f.Kind() == reflect.Ptr {
+		if isNA(v) {
+			return nil
+		}
+		f.Set(reflect.New(f.Type().Elem()))
+		f = f.Elem()
+	}
+
+	if f.Kind() == reflect.String {
+		if isNA(v) == false {
+			f.SetString(s.label(v))
+		}
+		return nil
+	}
+
+	if f.Type() == timeType {
+		if isNA(v) {
+			return fmt.Errorf("row %d of Series %s is missing and cannot be stored in a time.Time field", i, s.Name)
+		}
+		sec, frac := math.Modf(v)
+		f.Set(reflect.ValueOf(time.Unix(int64(sec), int64(frac*1e9)).UTC()))
+		return nil
+	}
+
+	switch f.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f.SetFloat(v)
+	case reflect.Bool:
+		if isNA(v) {
+			return fmt.Errorf("row %d of Series %s is missing and cannot be stored in a bool field", i, s.Name)
+		}
+		f.SetBool(v != 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// converting a value out of the range of int64 is not defined
+		if math.IsInf(v, 0) || v != math.Trunc(v) || v < math.MinInt64 || v >= 1<<63 || f.OverflowInt(int64(v)) {
+			return fmt.Errorf("row %d of Series %s has value %v, which cannot be stored in a field of type %s", i, s.Name, v, f.Type())
+		}
+		f.SetInt(int64(v))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if math.IsInf(v, 0) || v != math.Trunc(v) || v < 0 || v >= 1<<64 || f.OverflowUint(uint64(v)) {
+			return fmt.Errorf("row %d of Series %s has value %v, which cannot be stored in a field of type %s", i, s.Name, v, f.Type())
+		}
+		f.SetUint(uint64(v))
+	}
+
+	return nil
+}
+
+// structType returns the struct type of the elements of a slice,
+// which may be structs or pointers to structs.
+func structType(t reflect.Type) (reflect.Type, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("slice elements must be structs, not %s", t)
+	}
+
+	return t, nil
+}
+
+// structFields returns the exported fields of a struct that are mapped
+// to columns, returning an error if any have an unsupported type.
+func structFields(t reflect.Type) ([]structField, error) {
+	fields := []structField{}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		name := f.Tag.Get("gander")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		if isSupportedFieldType(f.Type) == false {
+			return nil, fmt.Errorf("field %s has unsupported type %s", f.Name, f.Type)
+		}
+
+		fields = append(fields, structField{name: name, index: i, typ: f.Type})
+	}
+
+	return fields, nil
+}
+
+func isSupportedFieldType(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+
+	return false
+}
+
+func isStringType(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.String
+}
+
+// structValue converts a numeric, bool or time.Time field to a float64.
+func structValue(v reflect.Value) float64 {
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		return float64(t.UnixNano()) / 1e9
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return 1
+		}
+		return 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	}
+
+	return float64(v.Uint())
+}
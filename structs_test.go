@@ -0,0 +1,142 @@
+package gander
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testRecord struct {
+	Name    string    `gander:"name"`
+	Age     int       `gander:"age"`
+	Height  *float64  `gander:"height"`
+	Member  bool      `gander:"member"`
+	Joined  time.Time `gander:"joined"`
+	Ignored string    `gander:"-"`
+	Score   float32
+	private int
+}
+
+func createTestRecords() []testRecord {
+	h := 1.8
+	return []testRecord{
+		{Name: "ann", Age: 30, Height: &h, Member: true, Joined: time.Unix(1500000000, 0).UTC(), Ignored: "x", Score: 1.5},
+		{Name: "bob", Age: 41, Joined: time.Unix(1600000000, 0).UTC(), Score: 2},
+	}
+}
+
+func TestFromStructs(t *testing.T) {
+	df, err := FromStructs(createTestRecords())
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"name", "age", "height", "member", "joined", "Score"}, df.ColumnNames(), "column names are not correct")
+	assert.Equal(t, true, (*df)[0].IsCategorical(), "string field should be categorical")
+	assert.Equal(t, "bob", (*df)[0].label((*df)[0].Values[1]), "label is not correct")
+	assert.Equal(t, []float64{30, 41}, (*df)[1].Values, "int values are not correct")
+	assert.Equal(t, true, valuesMatch([]float64{1.8, na}, (*df)[2].Values), "nil pointer should be missing")
+	assert.Equal(t, []float64{1, 0}, (*df)[3].Values, "bool values are not correct")
+	assert.Equal(t, []float64{1500000000, 1600000000}, (*df)[4].Values, "time values are not correct")
+}
+
+func TestFromStructsPointers(t *testing.T) {
+	r := createTestRecords()
+	df, err := FromStructs([]*testRecord{&r[0], &r[1]})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 2, df.Rows(), "row count is not correct")
+}
+
+func TestFromStructsErrors(t *testing.T) {
+	_, err := FromStructs(testRecord{})
+	assert.Equal(t, "FromStructs requires a slice of structs, not gander.testRecord", err.Error(), "error message is not correct")
+
+	_, err = FromStructs([]int{1})
+	assert.Equal(t, "slice elements must be structs, not int", err.Error(), "error message is not correct")
+
+	_, err = FromStructs([]struct{ M map[string]int }{})
+	assert.Equal(t, "field M has unsupported type map[string]int", err.Error(), "error message is not correct")
+}
+
+func TestToStructs(t *testing.T) {
+	df, _ := FromStructs(createTestRecords())
+
+	out := []testRecord{}
+	err := df.ToStructs(&out)
+	assert.Equal(t, nil, err, "error is not nil")
+
+	e := createTestRecords()
+	e[0].Ignored = ""
+	assert.Equal(t, e, out, "structs are not correct")
+
+	ptrs := []*testRecord{}
+	err = df.ToStructs(&ptrs)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, "bob", ptrs[1].Name, "name is not correct")
+}
+
+func TestToStructsConversions(t *testing.T) {
+	df := &DataFrame{
+		NewSeries("a", []float64{1, math.NaN()}),
+		NewCategoricalSeries("b", []string{"x", "y"}),
+	}
+
+	type record struct {
+		A *uint8  `gander:"a"`
+		S string  `gander:"a"`
+		B *string `gander:"b"`
+	}
+
+	out := []record{}
+	err := df.ToStructs(&out)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, uint8(1), *out[0].A, "value is not correct")
+	assert.Equal(t, true, out[1].A == nil, "missing value should be nil")
+	assert.Equal(t, "1", out[0].S, "numeric value should be formatted as a string")
+	assert.Equal(t, "", out[1].S, "missing value should be an empty string")
+	assert.Equal(t, "y", *out[1].B, "label is not correct")
+}
+
+func TestToStructsErrors(t *testing.T) {
+	df := &DataFrame{
+		NewSeries("a", []float64{1.5}),
+		NewCategoricalSeries("b", []string{"x"}),
+	}
+
+	var n []struct {
+		A int `gander:"a"`
+	}
+	err := df.ToStructs(&n)
+	assert.Equal(t, "row 0 of Series a has value 1.5, which cannot be stored in a field of type int", err.Error(), "error message is not correct")
+
+	inf := &DataFrame{NewSeries("a", []float64{math.Inf(1)})}
+	err = inf.ToStructs(&n)
+	assert.Equal(t, "row 0 of Series a has value +Inf, which cannot be stored in a field of type int", err.Error(), "error message is not correct")
+
+	var u []struct {
+		A uint64 `gander:"a"`
+	}
+	err = inf.ToStructs(&u)
+	assert.Equal(t, "row 0 of Series a has value +Inf, which cannot be stored in a field of type uint64", err.Error(), "error message is not correct")
+
+	big := &DataFrame{NewSeries("a", []float64{1e19})}
+	var i []struct {
+		A int64 `gander:"a"`
+	}
+	err = big.ToStructs(&i)
+	assert.Equal(t, "row 0 of Series a has value 1e+19, which cannot be stored in a field of type int64", err.Error(), "error message is not correct")
+
+	var c []struct {
+		B float64 `gander:"b"`
+	}
+	err = df.ToStructs(&c)
+	assert.Equal(t, "Series b is categorical and cannot be stored in field of type float64", err.Error(), "error message is not correct")
+
+	var m []struct {
+		C float64 `gander:"c"`
+	}
+	err = df.ToStructs(&m)
+	assert.Equal(t, "column 'c' does not exist in the DataFrame", err.Error(), "error message is not correct")
+
+	err = df.ToStructs(m)
+	assert.NotEqual(t, nil, err, "error is nil")
+}
@@ -0,0 +1,299 @@
+package gander
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// sparks holds the characters used to draw sparklines, from lowest to highest.
+var sparks = []rune("▁▂▃▄▅▆▇█")
+
+// maxSparklineWidth is the maximum number of characters in a sparkline.
+const maxSparklineWidth = 60
+
+// TextHistogram returns a histogram of the values in the Series drawn with
+// text characters, with one line per bin, and the longest bar width characters
+// long. Numeric values are counted in bins chosen using Sturges' rule, and
+// categorical values are counted for each category.
+func (s *Series) TextHistogram(width int) (string, error) {
+	if width < 1 {
+		return "", errors.New("width must be at least 1")
+	}
+
+	labels := []string{}
+	counts := []int{}
+
+	if s.IsCategorical() == true {
+		for _, v := range s.ValueCounts(false, false) {
+			if isNA(v.Value) == false {
+				labels = append(labels, v.Label)
+				counts = append(counts, v.Count)
+			}
+		}
+	} else {
+		h, err := s.HistogramAuto(BinsSturges)
+		if err != nil {
+			return "", err
+		}
+		labels = intervalLabels(h.Edges, true, true)
+		counts = h.Counts
+	}
+
+	labelWidth, maxCount := 0, 0
+	for i := range labels {
+		if len(labels[i]) > labelWidth {
+			labelWidth = len(labels[i])
+		}
+		if counts[i] > maxCount {
+			maxCount = counts[i]
+		}
+	}
+
+	output := ""
+	for i := range labels {
+		n := 0
+		if maxCount > 0 {
+			n = int(math.Round(float64(counts[i]) * float64(width) / float64(maxCount)))
+		}
+		output += fmt.Sprintf("%"+strconv.Itoa(labelWidth)+"s | %s %v\n", labels[i], strings.Repeat("#", n), counts[i])
+	}
+
+	return output, nil
+}
+
+// Sparkline returns the values of the Series, in order, drawn as a single
+// line of block characters whose heights follow the values. Long Series are
+// shortened by averaging neighbouring values, so that the sparkline is at most
+// 60 characters long. Missing (NaN) and infinite values are drawn as spaces,
+// and do not change the heights of the other values. The values
+// of a categorical Series have no order, so an empty string is returned.
+func (s *Series) Sparkline() string {
+	if s.IsCategorical() == true {
+		return ""
+	}
+
+	v := s.Values
+
+	if len(v) > maxSparklineWidth {
+		r := []float64{}
+		for i := 0; i < maxSparklineWidth; i++ {
+			lo := i * len(v) / maxSparklineWidth
+			hi := (i + 1) * len(v) / maxSparklineWidth
+			r = append(r, mean(v[lo:hi]))
+		}
+		v = r
+	}
+
+	return sparkline(v, false)
+}
+
+// TextBoxPlot returns a box plot of the values in the Series drawn with
+// text characters, as DataFrame.TextBoxPlot does. It returns an error if
+// the Series is categorical.
+func (s *Series) TextBoxPlot(width int) (string, error) {
+	if s.IsCategorical() == true {
+		return "", fmt.Errorf("Series %s is categorical", s.Name)
+	}
+
+	return (&DataFrame{s}).TextBoxPlot(width)
+}
+
+// TextBoxPlot returns a box plot of each numeric column of the DataFrame
+// drawn with text characters, with one line per column above a scale that
+// is shared by all the columns and is width characters wide. The box, drawn
+// as [ ], runs from the lower to the upper quartile, with the median marked
+// by | inside it. The whiskers extend to the most extreme values within 1.5
+// times the interquartile range of the box, and values beyond the whiskers
+// are drawn as o. Missing (NaN) and infinite values are skipped, and
+// categorical columns are left out.
+func (d *DataFrame) TextBoxPlot(width int) (string, error) {
+	if width < 1 {
+		return "", errors.New("width must be at least 1")
+	}
+
+	names := []string{}
+	boxes := []boxStats{}
+	lo, hi := math.Inf(1), math.Inf(-1)
+
+	for _, s := range *d {
+		if s.IsCategorical() == true {
+			continue
+		}
+
+		v := s.finite()
+		if len(v) == 0 {
+			return "", fmt.Errorf("Series %s has no values", s.Name)
+		}
+		sort.Float64s(v)
+
+		names = append(names, s.Name)
+		boxes = append(boxes, newBoxStats(v))
+		lo = math.Min(lo, v[0])
+		hi = math.Max(hi, v[len(v)-1])
+	}
+
+	if len(boxes) == 0 {
+		return "", errors.New("the DataFrame has no numeric columns")
+	}
+
+	position := func(x float64) int {
+		if hi == lo {
+			return (width - 1) / 2
+		}
+		return int(math.Round((x - lo) / (hi - lo) * float64(width-1)))
+	}
+
+	labelWidth := 0
+	for _, n := range names {
+		if len(n) > labelWidth {
+			labelWidth = len(n)
+		}
+	}
+
+	output := ""
+	for i, b := range boxes {
+		line := []rune(strings.Repeat(" ", width))
+		for x := position(b.lo); x <= position(b.hi); x++ {
+			line[x] = '-'
+		}
+		for x := position(b.q1); x <= position(b.q3); x++ {
+			line[x] = ' '
+		}
+		line[position(b.lo)] = '|'
+		line[position(b.hi)] = '|'
+		line[position(b.q1)] = '['
+		line[position(b.q3)] = ']'
+		line[position(b.median)] = '|'
+		for _, x := range b.outliers {
+			line[position(x)] = 'o'
+		}
+
+		output += fmt.Sprintf("%-"+strconv.Itoa(labelWidth)+"s  %s\n", names[i], strings.TrimRight(string(line), " "))
+	}
+
+	l, h := strconv.FormatFloat(lo, 'g', -1, 64), strconv.FormatFloat(hi, 'g', -1, 64)
+	gap := width - len(l) - len(h)
+	if gap < 1 {
+		gap = 1
+	}
+	output += strings.Repeat(" ", labelWidth+2) + l + strings.Repeat(" ", gap) + h + "\n"
+
+	return output, nil
+}
+
+// TextSummary returns a table with a row for each column of the DataFrame,
+// showing its type, the number of values that are not missing, summary
+// statistics for numeric columns, and a sparkline of its distribution. The
+// distribution of a numeric column is drawn from a histogram of its values,
+// and that of a categorical column from the counts of each category, from
+// the most to the least common.
+func (d *DataFrame) TextSummary() string {
+	rows := [][]string{{"column", "type", "count", "mean", "std", "min", "max", "distribution"}}
+
+	for _, s := range *d {
+		v := s.valid()
+		r := []string{s.Name, "numeric", strconv.Itoa(len(v)), "", "", "", "", ""}
+
+		if s.IsCategorical() == true {
+			r[1] = "categorical"
+			counts := []float64{}
+			for _, c := range s.ValueCounts(false, true) {
+				if isNA(c.Value) == false {
+					counts = append(counts, float64(c.Count))
+				}
+			}
+			r[7] = sparkline(counts, true)
+		} else if len(v) > 0 {
+			n := NewSeries(s.Name, v)
+			r[3] = fmt.Sprintf("%.2f", n.Mean())
+			r[4] = fmt.Sprintf("%.2f", n.StdDev())
+			r[5] = fmt.Sprintf("%.2f", n.Min())
+			r[6] = fmt.Sprintf("%.2f", n.Max())
+			if h, err := n.Histogram(10); err == nil {
+				counts := []float64{}
+				for _, c := range h.Counts {
+					counts = append(counts, float64(c))
+				}
+				r[7] = sparkline(counts, true)
+			}
+		}
+
+		rows = append(rows, r)
+	}
+
+	widths := make([]int, len(rows[0]))
+	for _, r := range rows {
+		for i, c := range r {
+			if l := len([]rune(c)); l > widths[i] {
+				widths[i] = l
+			}
+		}
+	}
+
+	output := ""
+	for _, r := range rows {
+		line := ""
+		for i, c := range r {
+			switch {
+			case i == len(r)-1:
+				line += c
+			case i < 2:
+				line += fmt.Sprintf("%-"+strconv.Itoa(widths[i])+"s  ", c)
+			default:
+				line += fmt.Sprintf("%"+strconv.Itoa(widths[i])+"s  ", c)
+			}
+		}
+		output += strings.TrimRight(line, " ") + "\n"
+	}
+
+	return output
+}
+
+// sparkline draws the values as block characters, scaled between the
+// smallest and largest values, or between zero and the largest value if
+// fromZero is true. Values that are not finite are drawn as spaces.
+func sparkline(v []float64, fromZero bool) string {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, x := range v {
+		if isFinite(x) == true {
+			lo = math.Min(lo, x)
+			hi = math.Max(hi, x)
+		}
+	}
+
+	if fromZero == true {
+		lo = 0
+	}
+
+	r := []rune{}
+	for _, x := range v {
+		switch {
+		case isFinite(x) == false:
+			r = append(r, ' ')
+		case hi == lo:
+			r = append(r, sparks[len(sparks)/2])
+		default:
+			r = append(r, sparks[int((x-lo)/(hi-lo)*float64(len(sparks)-1)+0.5)])
+		}
+	}
+
+	return string(r)
+}
+
+// mean returns the mean of the values that are not missing.
+func mean(v []float64) float64 {
+	t, n := 0.0, 0
+
+	for _, x := range v {
+		if isNA(x) == false {
+			t += x
+			n++
+		}
+	}
+
+	return t / float64(n)
+}
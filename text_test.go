@@ -0,0 +1,110 @@
+package gander
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextHistogramCategoricalData(t *testing.T) {
+	s := createTestCategoricalSeries()
+	h, err := s.TextHistogram(8)
+	assert.Equal(t, nil, err, "error is not nil")
+	e := `a | ######## 4
+b | ###### 3
+c | #### 2
+d | ## 1
+`
+	assert.Equal(t, e, h, "histogram not returned in correct format")
+}
+
+func TestTextHistogramNumericData(t *testing.T) {
+	s := createTestSeries()
+	h, err := s.TextHistogram(10)
+	assert.Equal(t, nil, err, "error is not nil")
+	e := `  [0, 1.4] | ######## 3
+(1.4, 2.8] | ### 1
+(2.8, 4.2] | ########## 4
+(4.2, 5.6] |  0
+  (5.6, 7] | ##### 2
+`
+	assert.Equal(t, e, h, "histogram not returned in correct format")
+}
+
+func TestTextHistogramInvalidWidth(t *testing.T) {
+	_, err := createTestSeries().TextHistogram(0)
+	assert.Equal(t, "width must be at least 1", err.Error(), "")
+}
+
+func TestSparkline(t *testing.T) {
+	s := NewSeries("x", []float64{0, 1, 2, 3, 4, 5, 6, 7, math.NaN(), 7})
+	assert.Equal(t, "▁▂▃▄▅▆▇█ █", s.Sparkline(), "sparkline is not correct")
+}
+
+func TestSparklineInfiniteValues(t *testing.T) {
+	s := NewSeries("x", []float64{1, math.Inf(1), 2, math.Inf(-1), 3})
+	assert.Equal(t, "▁ ▅ █", s.Sparkline(), "sparkline is not correct")
+}
+
+func TestSparklineCategoricalData(t *testing.T) {
+	s := createTestCategoricalSeries()
+	assert.Equal(t, "", s.Sparkline(), "sparkline of categorical data should be empty")
+}
+
+func TestSparklineLongSeries(t *testing.T) {
+	df, _ := LoadCSVFromPath("./testdata/MOCK_DATA.csv")
+	s := (*df)[0].Sparkline()
+	assert.Equal(t, 60, len([]rune(s)), "sparkline is too long")
+	assert.Equal(t, true, strings.HasPrefix(s, "▁") && strings.HasSuffix(s, "█"), "sparkline does not follow the values")
+}
+
+func TestTextBoxPlot(t *testing.T) {
+	b, err := createTestSeries().TextBoxPlot(15)
+	assert.Equal(t, nil, err, "error is not nil")
+	e := `MySeries  |--[  | ]-----|
+          0             7
+`
+	assert.Equal(t, e, b, "box plot not returned in correct format")
+}
+
+func TestTextBoxPlotOutliers(t *testing.T) {
+	s := NewSeries("x", []float64{0, 1, 2, math.NaN(), 3, 4, 20, math.Inf(1)})
+	b, err := s.TextBoxPlot(21)
+	assert.Equal(t, nil, err, "error is not nil")
+	e := `x  |[ |]               o
+   0                  20
+`
+	assert.Equal(t, e, b, "box plot not returned in correct format")
+}
+
+func TestTextBoxPlotDataFrame(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithCategoricalData())
+	b, err := df.TextBoxPlot(8)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, 5, strings.Count(b, "\n"), "there should be a line for each numeric column and the scale")
+}
+
+func TestTextBoxPlotInvalidSeries(t *testing.T) {
+	_, err := createTestCategoricalSeries().TextBoxPlot(10)
+	assert.Equal(t, "Series MySeries is categorical", err.Error(), "error is not correct")
+
+	_, err = NewSeries("x", []float64{math.NaN()}).TextBoxPlot(10)
+	assert.Equal(t, "Series x has no values", err.Error(), "error is not correct")
+
+	_, err = createTestSeries().TextBoxPlot(0)
+	assert.Equal(t, "width must be at least 1", err.Error(), "error is not correct")
+}
+
+func TestTextSummary(t *testing.T) {
+	df, _ := NewDataFrame(createSampleDataWithCategoricalData())
+	e := `column  type         count  mean   std   min   max  distribution
+a       numeric          4  3.75  2.17  1.00  7.00  █▁▁██▁▁▁▁█
+b       numeric          4  3.75  1.79  2.00  6.00  █▁▁▁▁▁▁▅▁▅
+c       numeric          4  2.50  1.12  1.00  4.00  █▁▁█▁▁█▁▁█
+d       categorical      4                          ██
+e       numeric          4  4.50  1.12  3.00  6.00  █▁▁█▁▁█▁▁█
+`
+	assert.Equal(t, e, df.TextSummary(), "summary not returned in correct format")
+}
@@ -0,0 +1,485 @@
+package gander
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// XLSXOptions control how an Excel workbook is loaded.
+type XLSXOptions struct {
+	// DateFormat is the layout, as used by time.Format, used to convert
+	// date cells to text, giving a categorical Series. If it is empty,
+	// date cells are converted to Unix timestamps in seconds.
+	DateFormat string
+	// Schema, if not nil, is used to convert the columns as
+	// NewDataFrameWithSchema does.
+	Schema Schema
+}
+
+// LoadXLSX creates a DataFrame from a sheet of an Excel (.xlsx) workbook.
+// If sheet is empty, the first sheet is loaded. The cells are converted to
+// text and passed to NewDataFrame, so the first row is used as headers and
+// the type of each column is chosen in the same way as for a csv file. Empty
+// cells are missing (NaN) values.
+func LoadXLSX(path string, sheet string, opts XLSXOptions) (*DataFrame, error) {
+	z, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer z.Close()
+
+	w, err := readWorkbook(&z.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := w.readSheet(sheet, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDataFrame(data, opts.Schema)
+}
+
+// XLSXSheets returns the names of the sheets in an Excel (.xlsx) workbook.
+func XLSXSheets(path string) ([]string, error) {
+	z, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer z.Close()
+
+	w, err := readWorkbook(&z.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, s := range w.sheets {
+		names = append(names, s.name)
+	}
+
+	return names, nil
+}
+
+// WriteXLSX writes the DataFrame to a new Excel (.xlsx) workbook at the
+// provided path, with a single sheet with the provided name. The first row
+// holds the column names. Missing (NaN) values are written as empty cells.
+func (d *DataFrame) WriteXLSX(path string, sheet string) error {
+	if sheet == "" {
+		sheet = "Sheet1"
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	z := zip.NewWriter(f)
+	err = d.writeXLSX(z, sheet)
+	if err == nil {
+		err = z.Close()
+	}
+
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+type workbook struct {
+	z       *zip.Reader
+	sheets  []workbookSheet
+	strings []string
+	dates   map[int]bool
+}
+
+type workbookSheet struct {
+	name string
+	path string
+}
+
+func readWorkbook(z *zip.Reader) (*workbook, error) {
+	w := workbook{z: z, dates: map[int]bool{}}
+
+	var wb struct {
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+			ID   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	if err := w.decode("xl/workbook.xml", &wb); err != nil {
+		return nil, err
+	}
+
+	var rels struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if err := w.decode("xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return nil, err
+	}
+
+	for _, s := range wb.Sheets {
+		for _, r := range rels.Relationships {
+			if r.ID == s.ID {
+				p := r.Target
+				if strings.HasPrefix(p, "/") {
+					p = strings.TrimPrefix(p, "/")
+				} else {
+					p = path.Join("xl", p)
+				}
+				w.sheets = append(w.sheets, workbookSheet{name: s.Name, path: p})
+			}
+		}
+	}
+
+	var sst struct {
+		Items []struct {
+			T    string `xml:"t"`
+			Runs []struct {
+				T string `xml:"t"`
+			} `xml:"r"`
+		} `xml:"si"`
+	}
+	if err := w.decode("xl/sharedStrings.xml", &sst); err != nil && err != errMissingPart {
+		return nil, err
+	}
+	for _, si := range sst.Items {
+		t := si.T
+		for _, r := range si.Runs {
+			t += r.T
+		}
+		w.strings = append(w.strings, t)
+	}
+
+	var styles struct {
+		NumFmts []struct {
+			ID   int    `xml:"numFmtId,attr"`
+			Code string `xml:"formatCode,attr"`
+		} `xml:"numFmts>numFmt"`
+		CellXfs []struct {
+			NumFmtID int `xml:"numFmtId,attr"`
+		} `xml:"cellXfs>xf"`
+	}
+	if err := w.decode("xl/styles.xml", &styles); err != nil && err != errMissingPart {
+		return nil, err
+	}
+	custom := map[int]string{}
+	for _, f := range styles.NumFmts {
+		custom[f.ID] = f.Code
+	}
+	for i, x := range styles.CellXfs {
+		if code, ok := custom[x.NumFmtID]; ok {
+			w.dates[i] = isDateFormatCode(code)
+		} else {
+			w.dates[i] = (x.NumFmtID >= 14 && x.NumFmtID <= 22) || (x.NumFmtID >= 45 && x.NumFmtID <= 47)
+		}
+	}
+
+	return &w, nil
+}
+
+var errMissingPart = errors.New("part is missing from the workbook")
+
+func (w *workbook) decode(name string, v interface{}) error {
+	for _, f := range w.z.File {
+		if f.Name == name {
+			r, err := f.Open()
+			if err != nil {
+				return err
+			}
+			defer r.Close()
+			return xml.NewDecoder(r).Decode(v)
+		}
+	}
+
+	return errMissingPart
+}
+
+// readSheet returns the cells of the named sheet as text, with
+// every row padded to the same number of columns.
+func (w *workbook) readSheet(name string, opts XLSXOptions) ([][]string, error) {
+	if len(w.sheets) == 0 {
+		return nil, errors.New("workbook has no sheets")
+	}
+
+	s := w.sheets[0]
+	if name != "" {
+		found := false
+		for _, v := range w.sheets {
+			if v.name == name {
+				s, found = v, true
+			}
+		}
+		if found == false {
+			return nil, fmt.Errorf("sheet '%s' does not exist in the workbook", name)
+		}
+	}
+
+	var ws struct {
+		Rows []struct {
+			R     int `xml:"r,attr"`
+			Cells []struct {
+				R      string `xml:"r,attr"`
+				T      string `xml:"t,attr"`
+				S      int    `xml:"s,attr"`
+				V      string `xml:"v"`
+				Inline struct {
+					T string `xml:"t"`
+				} `xml:"is"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	if err := w.decode(s.path, &ws); err != nil {
+		if err == errMissingPart {
+			return nil, fmt.Errorf("sheet '%s' is missing from the workbook", s.name)
+		}
+		return nil, err
+	}
+
+	data := [][]string{}
+	width := 0
+
+	for i, r := range ws.Rows {
+		row := r.R - 1
+		if row < 0 {
+			row = i
+		}
+		for len(data) <= row {
+			data = append(data, []string{})
+		}
+
+		for j, c := range r.Cells {
+			col := j
+			if c.R != "" {
+				col = cellColumn(c.R)
+			}
+
+			var v string
+			switch c.T {
+			case "s":
+				n, err := strconv.Atoi(c.V)
+				if err != nil || n < 0 || n >= len(w.strings) {
+					return nil, fmt.Errorf("cell %s refers to a missing shared string", c.R)
+				}
+				v = w.strings[n]
+			case "inlineStr":
+				v = c.Inline.T
+			case "b":
+				v = "FALSE"
+				if c.V == "1" {
+					v = "TRUE"
+				}
+			case "", "n":
+				v = c.V
+				if w.dates[c.S] == true && c.V != "" {
+					v = formatExcelDate(c.V, opts)
+				}
+			default:
+				v = c.V
+			}
+
+			for len(data[row]) <= col {
+				data[row] = append(data[row], "")
+			}
+			data[row][col] = v
+			if col+1 > width {
+				width = col + 1
+			}
+		}
+	}
+
+	for i := range data {
+		for len(data[i]) < width {
+			data[i] = append(data[i], "")
+		}
+	}
+
+	return data, nil
+}
+
+// cellColumn returns the zero based column number of a cell
+// reference such as "AB12".
+func cellColumn(ref string) int {
+	c := 0
+
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		c = c*26 + int(r-'A') + 1
+	}
+
+	return c - 1
+}
+
+// cellReference returns the reference of the cell at the zero
+// based row and column, such as "AB12".
+func cellReference(row, col int) string {
+	n := ""
+
+	for col++; col > 0; col = (col - 1) / 26 {
+		n = string(rune('A'+(col-1)%26)) + n
+	}
+
+	return n + strconv.Itoa(row+1)
+}
+
+// excelEpoch is the date that Excel serial date 0 represents,
+// allowing for Excel treating 1900 as a leap year.
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+func formatExcelDate(v string, opts XLSXOptions) string {
+	serial, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return v
+	}
+
+	t := excelEpoch.Add(time.Duration(math.Round(serial*24*60*60*1000)) * time.Millisecond)
+
+	if opts.DateFormat != "" {
+		return t.Format(opts.DateFormat)
+	}
+
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+// isDateFormatCode returns true if a number format code displays a date
+// or time, ignoring quoted text, escaped characters and colours.
+func isDateFormatCode(code string) bool {
+	quoted, escaped, bracket := false, false, false
+
+	for _, r := range strings.ToLower(code) {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case quoted:
+		case r == '[':
+			bracket = true
+		case r == ']':
+			bracket = false
+		case bracket:
+		case strings.ContainsRune("dmyhs", r):
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *DataFrame) writeXLSX(z *zip.Writer, sheet string) error {
+	strs := []string{}
+	index := map[string]int{}
+	shared := func(s string) int {
+		if i, ok := index[s]; ok {
+			return i
+		}
+		index[s] = len(strs)
+		strs = append(strs, s)
+		return index[s]
+	}
+
+	b := strings.Builder{}
+	b.WriteString(xml.Header)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	b.WriteString(`<row r="1">`)
+	for c, s := range *d {
+		fmt.Fprintf(&b, `<c r="%s" t="s"><v>%d</v></c>`, cellReference(0, c), shared(s.Name))
+	}
+	b.WriteString(`</row>`)
+
+	for r := 0; r < d.rowCount(); r++ {
+		fmt.Fprintf(&b, `<row r="%d">`, r+2)
+		for c, s := range *d {
+			v := s.Values[r]
+			switch {
+			case isNA(v):
+			case s.IsCategorical():
+				fmt.Fprintf(&b, `<c r="%s" t="s"><v>%d</v></c>`, cellReference(r+1, c), shared(s.categoricalLabels[v]))
+			default:
+				fmt.Fprintf(&b, `<c r="%s"><v>%s</v></c>`, cellReference(r+1, c), strconv.FormatFloat(v, 'g', -1, 64))
+			}
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+
+	sst := strings.Builder{}
+	sst.WriteString(xml.Header)
+	fmt.Fprintf(&sst, `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" count="%d" uniqueCount="%d">`, len(strs), len(strs))
+	for _, s := range strs {
+		sst.WriteString(`<si><t xml:space="preserve">`)
+		xml.EscapeText(&sst, []byte(s))
+		sst.WriteString(`</t></si>`)
+	}
+	sst.WriteString(`</sst>`)
+
+	name := strings.Builder{}
+	xml.EscapeText(&name, []byte(sheet))
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`<Override PartName="/xl/sharedStrings.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sharedStrings+xml"/>` +
+			`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+			`</Types>`},
+		{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`},
+		{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="` + name.String() + `" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings" Target="sharedStrings.xml"/>` +
+			`<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+			`</Relationships>`},
+		{"xl/worksheets/sheet1.xml", b.String()},
+		{"xl/sharedStrings.xml", sst.String()},
+		{"xl/styles.xml", xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+			`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+			`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+			`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+			`<cellXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/></cellXfs>` +
+			`</styleSheet>`},
+	}
+
+	for _, p := range parts {
+		w, err := z.Create(p.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, p.content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package gander
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestWorkbook(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "gander")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := filepath.Join(dir, "test.xlsx")
+	f, err := os.Create(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	z := zip.NewWriter(f)
+	parts := map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="First" sheetId="1" r:id="rId1"/><sheet name="Second" sheetId="2" r:id="rId2"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Target="worksheets/sheet1.xml"/><Relationship Id="rId2" Target="/xl/worksheets/sheet2.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<si><t>name</t></si><si><t>when</t></si><si><r><t>al</t></r><r><t>pha</t></r></si><si><t>value</t></si></sst>`,
+		"xl/styles.xml": `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<numFmts><numFmt numFmtId="164" formatCode="yyyy\-mm\-dd"/></numFmts>
+<cellXfs><xf numFmtId="0"/><xf numFmtId="14"/><xf numFmtId="164"/></cellXfs></styleSheet>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="s"><v>3</v></c></row>
+<row r="2"><c r="A2" t="s"><v>2</v></c><c r="B2" s="1"><v>43831</v></c><c r="C2"><v>1.5</v></c></row>
+<row r="3"><c r="A3" t="inlineStr"><is><t>beta</t></is></c><c r="B3" s="2"><v>43832.5</v></c><c r="C3"><v>2</v></c></row>
+</sheetData></worksheet>`,
+		"xl/worksheets/sheet2.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1"><v>1</v></c><c r="C1"><v>3</v></c></row>
+<row r="3"><c r="B3"><v>5</v></c></row>
+</sheetData></worksheet>`,
+	}
+	for n, c := range parts {
+		w, err := z.Create(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(c))
+	}
+	z.Close()
+	f.Close()
+
+	return p
+}
+
+func TestLoadXLSX(t *testing.T) {
+	p := createTestWorkbook(t)
+	defer os.RemoveAll(filepath.Dir(p))
+
+	df, err := LoadXLSX(p, "", XLSXOptions{})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"name", "when", "value"}, df.ColumnNames(), "column names are not correct")
+	assert.Equal(t, true, (*df)[0].IsCategorical(), "name should be categorical")
+	assert.Equal(t, "alpha", (*df)[0].label((*df)[0].Values[0]), "shared string runs should be joined")
+	assert.Equal(t, "beta", (*df)[0].label((*df)[0].Values[1]), "inline string is not correct")
+	assert.Equal(t, []float64{1577836800, 1577966400}, (*df)[1].Values, "dates should be Unix timestamps")
+	assert.Equal(t, []float64{1.5, 2}, (*df)[2].Values, "values are not correct")
+}
+
+func TestLoadXLSXDateFormat(t *testing.T) {
+	p := createTestWorkbook(t)
+	defer os.RemoveAll(filepath.Dir(p))
+
+	df, err := LoadXLSX(p, "First", XLSXOptions{DateFormat: "2006-01-02 15:04"})
+	assert.Equal(t, nil, err, "error is not nil")
+	s := (*df)[1]
+	assert.Equal(t, true, s.IsCategorical(), "dates should be categorical")
+	assert.Equal(t, "2020-01-01 00:00", s.label(s.Values[0]), "date is not correct")
+	assert.Equal(t, "2020-01-02 12:00", s.label(s.Values[1]), "date is not correct")
+}
+
+func TestLoadXLSXSheet(t *testing.T) {
+	p := createTestWorkbook(t)
+	defer os.RemoveAll(filepath.Dir(p))
+
+	df, err := LoadXLSX(p, "Second", XLSXOptions{})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"Column 1", "Column 2", "Column 3"}, df.ColumnNames(), "column names are not correct")
+	assert.Equal(t, 3, df.Rows(), "row count is not correct")
+	assert.Equal(t, false, (*df)[1].IsCategorical(), "empty cells should be missing values")
+	assert.Equal(t, true, valuesMatch([]float64{math.NaN(), math.NaN(), 5}, (*df)[1].Values), "values are not correct")
+}
+
+func TestLoadXLSXMissingSheet(t *testing.T) {
+	p := createTestWorkbook(t)
+	defer os.RemoveAll(filepath.Dir(p))
+
+	_, err := LoadXLSX(p, "Third", XLSXOptions{})
+	assert.Equal(t, "sheet 'Third' does not exist in the workbook", err.Error(), "error message is not correct")
+}
+
+func TestXLSXSheets(t *testing.T) {
+	p := createTestWorkbook(t)
+	defer os.RemoveAll(filepath.Dir(p))
+
+	s, err := XLSXSheets(p)
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"First", "Second"}, s, "sheet names are not correct")
+}
+
+func TestWriteXLSX(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "gander")
+	defer os.RemoveAll(dir)
+	p := filepath.Join(dir, "out.xlsx")
+
+	df := &DataFrame{
+		NewSeries("a", []float64{1.25, 2, 3}),
+		NewCategoricalSeries("b & c", []string{"x", "<y>", "x"}),
+	}
+	err := df.WriteXLSX(p, "Data")
+	assert.Equal(t, nil, err, "error is not nil")
+
+	s, _ := XLSXSheets(p)
+	assert.Equal(t, []string{"Data"}, s, "sheet names are not correct")
+
+	r, err := LoadXLSX(p, "Data", XLSXOptions{})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, []string{"a", "b & c"}, r.ColumnNames(), "column names are not correct")
+	assert.Equal(t, []float64{1.25, 2, 3}, (*r)[0].Values, "values are not correct")
+	assert.Equal(t, "<y>", (*r)[1].label((*r)[1].Values[1]), "label is not correct")
+}
+
+func TestWriteXLSXMissingValues(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "gander")
+	defer os.RemoveAll(dir)
+	p := filepath.Join(dir, "out.xlsx")
+
+	df := &DataFrame{
+		NewSeries("a", []float64{1, math.NaN(), 3}),
+		NewCategoricalSeries("b", []string{"x", "y", "x"}),
+	}
+	(*df)[1].Values[0] = math.NaN()
+	err := df.WriteXLSX(p, "")
+	assert.Equal(t, nil, err, "error is not nil")
+
+	r, err := LoadXLSX(p, "Sheet1", XLSXOptions{})
+	assert.Equal(t, nil, err, "error is not nil")
+	assert.Equal(t, false, (*r)[0].IsCategorical(), "column with missing values should be numeric")
+	assert.Equal(t, true, valuesMatch([]float64{1, math.NaN(), 3}, (*r)[0].Values), "missing value was not read back")
+	assert.Equal(t, true, math.IsNaN((*r)[1].Values[0]), "missing category was not read back")
+	assert.Equal(t, "y", (*r)[1].label((*r)[1].Values[1]), "label is not correct")
+}
+
+func TestCellReference(t *testing.T) {
+	assert.Equal(t, "A1", cellReference(0, 0), "reference is not correct")
+	assert.Equal(t, "AB12", cellReference(11, 27), "reference is not correct")
+	assert.Equal(t, 27, cellColumn("AB12"), "column is not correct")
+	assert.Equal(t, 701, cellColumn(cellReference(0, 701)), "column is not correct")
+}
+
+func TestIsDateFormatCode(t *testing.T) {
+	assert.Equal(t, true, isDateFormatCode("dd/mm/yyyy"), "should be a date format")
+	assert.Equal(t, false, isDateFormatCode(`0.00" days"`), "quoted text should be ignored")
+	assert.Equal(t, false, isDateFormatCode("[Red]0.00"), "colours should be ignored")
+}